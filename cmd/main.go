@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"os"
+	"time"
 
 	"github.com/SawitProRecruitment/UserService/generated"
 	"github.com/SawitProRecruitment/UserService/handler"
@@ -11,21 +13,64 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 )
 
+const (
+	// defaultPurgeRetention is how long the deleted records are kept before
+	// being purged, unless PURGE_RETENTION is set.
+	defaultPurgeRetention = 30 * 24 * time.Hour
+
+	// purgeInterval is how often the records past the retention are purged.
+	purgeInterval = time.Hour
+)
+
 func main() {
 	e := echo.New()
 
-	var server generated.ServerInterface = newServer()
+	retention, err := purgeRetention()
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	repo := newRepository()
+	var server generated.ServerInterface = handler.NewServer(repo)
+	go purgeDeleted(e.Logger, repo, retention)
 
 	generated.RegisterHandlers(e, server)
 	e.Use(middleware.Logger())
 	e.Logger.Fatal(e.Start(":1323"))
 }
 
-func newServer() *handler.Server {
+func newRepository() repository.RepositoryInterface {
 	dbDsn := os.Getenv("DATABASE_URL")
-	var repo repository.RepositoryInterface = repository.NewRepository(repository.NewRepositoryOptions{
+	return repository.NewRepository(repository.NewRepositoryOptions{
 		Dsn: dbDsn,
 	})
+}
+
+// purgeRetention reads the retention of the deleted records from
+// PURGE_RETENTION, a duration such as 720h.
+func purgeRetention() (time.Duration, error) {
+	value := os.Getenv("PURGE_RETENTION")
+	if value == "" {
+		return defaultPurgeRetention, nil
+	}
+
+	return time.ParseDuration(value)
+}
+
+// purgeDeleted permanently deletes the records deleted for longer than the
+// retention, on start and then every purgeInterval.
+func purgeDeleted(logger echo.Logger, repo repository.RepositoryInterface, retention time.Duration) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
 
-	return handler.NewServer(repo)
+	for ; ; <-ticker.C {
+		purged, err := repo.PurgeDeleted(context.Background(), time.Now().Add(-retention))
+		if err != nil {
+			logger.Errorf("purging deleted records: %v", err)
+			continue
+		}
+		if purged > 0 {
+			logger.Infof("purged %d deleted records", purged)
+		}
+	}
 }
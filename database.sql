@@ -12,11 +12,52 @@ CREATE TABLE
 		"id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4 ()),
 		"length" integer NOT NULL,
 		"width" integer NOT NULL,
+		"plot_size" integer NOT NULL DEFAULT (10),
+		"origin_latitude" double precision,
+		"origin_longitude" double precision,
+		"rotation" double precision NOT NULL DEFAULT (0),
+		"clearance" integer NOT NULL DEFAULT (1),
+		"takeoff_altitude" integer NOT NULL DEFAULT (1),
+		"landing_altitude" integer NOT NULL DEFAULT (1),
+		"cruise_floor" integer NOT NULL DEFAULT (1),
+		"home_x" integer,
+		"home_y" integer,
+		"boundary" jsonb,
+		"elevation" jsonb,
 		"created_at" timestamp NOT NULL DEFAULT (now ()),
 		"updated_at" timestamp NOT NULL DEFAULT (now ()),
-		"deleted_at" timestamp
+		"deleted_at" timestamp,
+		CHECK ("plot_size" > 0),
+		CHECK ("clearance" >= 0),
+		CHECK ("takeoff_altitude" > 0),
+		CHECK ("landing_altitude" > 0),
+		CHECK ("cruise_floor" > 0),
+		CHECK (("origin_latitude" IS NULL) = ("origin_longitude" IS NULL)),
+		CHECK (("home_x" IS NULL) = ("home_y" IS NULL))
 	);
 
+CREATE INDEX ON "estates" ("created_at", "id") WHERE "deleted_at" IS NULL;
+
+-- plot_inside reports whether plot (x, y) of an estate is inside its
+-- boundary, as helper.InsideEstate does: the center of the plot has an odd
+-- number of boundary crossings of its row on its left and none on it.
+CREATE FUNCTION
+	"plot_inside" ("boundary" jsonb, "x" integer, "y" integer) RETURNS boolean LANGUAGE sql IMMUTABLE AS $$
+	SELECT "boundary" IS NULL OR (
+		SELECT COUNT(*) FILTER (WHERE "crossing" < "x"::double precision - 0.5) % 2 = 1
+			AND COUNT(*) FILTER (WHERE "crossing" = "x"::double precision - 0.5) = 0
+		FROM (
+			SELECT ("a"->>'x')::double precision
+				+ ("y"::double precision - 0.5 - ("a"->>'y')::double precision)
+				* (("b"->>'x')::integer - ("a"->>'x')::integer)
+				/ (("b"->>'y')::integer - ("a"->>'y')::integer) AS "crossing"
+			FROM jsonb_array_elements("boundary") WITH ORDINALITY AS "vertices" ("a", "i"),
+				LATERAL (SELECT "boundary" -> ("i" % jsonb_array_length("boundary"))::integer AS "b") AS "next"
+			WHERE (("a"->>'y')::integer < "y"::double precision - 0.5) <> (("b"->>'y')::integer < "y"::double precision - 0.5)
+		) AS "crossings"
+	)
+$$;
+
 CREATE TABLE
 	"trees" (
 		"id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4 ()),
@@ -29,6 +70,83 @@ CREATE TABLE
 		"deleted_at" timestamp
 	);
 
-CREATE UNIQUE INDEX ON "trees" ("estate_id", "x", "y");
+CREATE UNIQUE INDEX ON "trees" ("estate_id", "x", "y") WHERE "deleted_at" IS NULL;
+CREATE INDEX ON "trees" ("estate_id", "height", "id") WHERE "deleted_at" IS NULL;
+CREATE INDEX ON "trees" ("estate_id", "created_at", "id") WHERE "deleted_at" IS NULL;
+
+ALTER TABLE "trees" ADD FOREIGN KEY ("estate_id") REFERENCES "estates" ("id");
+
+CREATE TABLE
+	"tree_heights" (
+		"id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4 ()),
+		"tree_id" uuid NOT NULL,
+		"height" integer NOT NULL,
+		"measured_at" timestamp NOT NULL DEFAULT (now ())
+	);
+
+CREATE INDEX ON "tree_heights" ("tree_id", "measured_at");
 
-ALTER TABLE "trees" ADD FOREIGN KEY ("estate_id") REFERENCES "estates" ("id");
\ No newline at end of file
+ALTER TABLE "tree_heights" ADD FOREIGN KEY ("tree_id") REFERENCES "trees" ("id");
+
+CREATE TABLE
+	"landing_zones" (
+		"id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4 ()),
+		"estate_id" uuid NOT NULL,
+		"x" integer NOT NULL,
+		"y" integer NOT NULL,
+		"created_at" timestamp NOT NULL DEFAULT (now ()),
+		"updated_at" timestamp NOT NULL DEFAULT (now ()),
+		"deleted_at" timestamp
+	);
+
+CREATE UNIQUE INDEX ON "landing_zones" ("estate_id", "x", "y") WHERE "deleted_at" IS NULL;
+
+ALTER TABLE "landing_zones" ADD FOREIGN KEY ("estate_id") REFERENCES "estates" ("id");
+
+CREATE TABLE
+	"restricted_areas" (
+		"id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4 ()),
+		"estate_id" uuid NOT NULL,
+		"kind" varchar NOT NULL,
+		"x" integer NOT NULL,
+		"y" integer NOT NULL,
+		"length" integer NOT NULL DEFAULT (1),
+		"width" integer NOT NULL DEFAULT (1),
+		"height" integer NOT NULL DEFAULT (0),
+		"created_at" timestamp NOT NULL DEFAULT (now ()),
+		"updated_at" timestamp NOT NULL DEFAULT (now ()),
+		"deleted_at" timestamp,
+		CHECK ("kind" IN ('no_fly', 'obstacle')),
+		CHECK ("length" > 0),
+		CHECK ("width" > 0),
+		CHECK (("kind" = 'obstacle') = ("height" > 0))
+	);
+
+CREATE INDEX ON "restricted_areas" ("estate_id");
+
+ALTER TABLE "restricted_areas" ADD FOREIGN KEY ("estate_id") REFERENCES "estates" ("id");
+
+CREATE TABLE
+	"drones" (
+		"id" uuid PRIMARY KEY DEFAULT (uuid_generate_v4 ()),
+		"model" varchar NOT NULL,
+		"range" integer NOT NULL,
+		"speed" double precision NOT NULL,
+		"climb_rate" double precision NOT NULL,
+		"descend_rate" double precision NOT NULL,
+		"horizontal_energy" double precision NOT NULL DEFAULT (0),
+		"climb_energy" double precision NOT NULL DEFAULT (0),
+		"descend_energy" double precision NOT NULL DEFAULT (0),
+		"clearance" integer NOT NULL,
+		"created_at" timestamp NOT NULL DEFAULT (now ()),
+		"updated_at" timestamp NOT NULL DEFAULT (now ()),
+		"deleted_at" timestamp,
+		CHECK ("range" > 0),
+		CHECK ("speed" > 0),
+		CHECK ("climb_rate" > 0),
+		CHECK ("descend_rate" > 0),
+		CHECK ("horizontal_energy" >= 0),
+		CHECK ("climb_energy" >= 0),
+		CHECK ("descend_energy" >= 0),
+		CHECK ("clearance" >= 0)
+	);
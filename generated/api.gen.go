@@ -12,16 +12,100 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for CreateRestrictedAreaRequestKind.
+const (
+	NoFly    CreateRestrictedAreaRequestKind = "no_fly"
+	Obstacle CreateRestrictedAreaRequestKind = "obstacle"
+)
+
+// Defines values for DronePlanStrategy.
+const (
+	Auto   DronePlanStrategy = "auto"
+	Column DronePlanStrategy = "column"
+	Row    DronePlanStrategy = "row"
+	Spiral DronePlanStrategy = "spiral"
+	Trees  DronePlanStrategy = "trees"
+)
+
+// Defines values for FlightProfile.
+const (
+	Fixed   FlightProfile = "fixed"
+	Smooth  FlightProfile = "smooth"
+	Terrain FlightProfile = "terrain"
+)
+
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
+// Defines values for GetEstateParamsSort.
+const (
+	GetEstateParamsSortArea      GetEstateParamsSort = "area"
+	GetEstateParamsSortCreatedAt GetEstateParamsSort = "created_at"
+)
+
+// Defines values for GetEstateIdDronePlanParamsInclude.
+const (
+	GetEstateIdDronePlanParamsIncludeWaypoints GetEstateIdDronePlanParamsInclude = "waypoints"
+)
+
+// Defines values for GetEstateIdDronePlanMissionParamsFormat.
+const (
+	GetEstateIdDronePlanMissionParamsFormatPlan      GetEstateIdDronePlanMissionParamsFormat = "plan"
+	GetEstateIdDronePlanMissionParamsFormatWaypoints GetEstateIdDronePlanMissionParamsFormat = "waypoints"
+)
+
+// Defines values for GetEstateIdTreeParamsSort.
+const (
+	GetEstateIdTreeParamsSortCreatedAt GetEstateIdTreeParamsSort = "created_at"
+	GetEstateIdTreeParamsSortHeight    GetEstateIdTreeParamsSort = "height"
+	GetEstateIdTreeParamsSortPosition  GetEstateIdTreeParamsSort = "position"
+)
+
+// BoundaryResponse defines model for BoundaryResponse.
+type BoundaryResponse struct {
+	Boundary []Vertex `json:"boundary"`
+
+	// Plots Number of plots of the estate
+	Plots int `json:"plots"`
+}
+
 // CreateEstateRequest defines model for CreateEstateRequest.
 type CreateEstateRequest struct {
-	Length int `json:"length"`
-	Width  int `json:"width"`
+	// Boundary Polygon enclosing the plots of an estate that is not a full rectangle, a plot is part of the estate when its center is inside
+	Boundary *[]Vertex `json:"boundary,omitempty"`
+
+	// DroneProfile Flight parameters of the drone in meters, every missing one defaults to 1
+	DroneProfile *DroneProfile `json:"drone_profile,omitempty"`
+
+	// Home Plot where the drone takes off, lands and rests, it may be outside of the estate. Without it, the drone takes off from the first plot flown over and lands on the last one.
+	Home   *Home `json:"home,omitempty"`
+	Length int   `json:"length"`
+
+	// Origin WGS84 coordinate, as the origin of an estate it is the outer corner of plot (1, 1)
+	Origin *Location `json:"origin,omitempty"`
+
+	// PlotSize Length in meters of the side of a plot, defaults to 10
+	PlotSize *int `json:"plot_size,omitempty"`
+
+	// Rotation Degrees counterclockwise from east of the estate length, defaults to 0
+	Rotation *float64 `json:"rotation,omitempty"`
+	Width    int      `json:"width"`
+}
+
+// CreateLandingZoneRequest defines model for CreateLandingZoneRequest.
+type CreateLandingZoneRequest struct {
+	X int `json:"x"`
+	Y int `json:"y"`
 }
 
 // CreateResponse defines model for CreateResponse.
@@ -29,6 +113,28 @@ type CreateResponse struct {
 	Id string `json:"id"`
 }
 
+// CreateRestrictedAreaRequest defines model for CreateRestrictedAreaRequest.
+type CreateRestrictedAreaRequest struct {
+	// Height Height in meters of an obstacle, required for obstacles only
+	Height *int                            `json:"height,omitempty"`
+	Kind   CreateRestrictedAreaRequestKind `json:"kind"`
+
+	// Length Number of plots of the area along the length, 1 by default
+	Length *int `json:"length,omitempty"`
+
+	// Width Number of plots of the area along the width, 1 by default
+	Width *int `json:"width,omitempty"`
+
+	// X First plot of the area along the length
+	X int `json:"x"`
+
+	// Y First plot of the area along the width
+	Y int `json:"y"`
+}
+
+// CreateRestrictedAreaRequestKind defines model for CreateRestrictedAreaRequest.Kind.
+type CreateRestrictedAreaRequestKind string
+
 // CreateTreeRequest defines model for CreateTreeRequest.
 type CreateTreeRequest struct {
 	Height int `json:"height"`
@@ -36,54 +142,742 @@ type CreateTreeRequest struct {
 	Y      int `json:"y"`
 }
 
+// Drone defines model for Drone.
+type Drone struct {
+	// Clearance Meters the drone must fly above the trees
+	Clearance int `json:"clearance"`
+
+	// ClimbEnergy Battery energy in watt-hours per meter climbed, defaults to 0
+	ClimbEnergy *float64 `json:"climb_energy,omitempty"`
+
+	// ClimbRate Vertical speed in meters per second when climbing
+	ClimbRate float64 `json:"climb_rate"`
+
+	// DescendEnergy Battery energy in watt-hours per meter descended, defaults to 0
+	DescendEnergy *float64 `json:"descend_energy,omitempty"`
+
+	// DescendRate Vertical speed in meters per second when descending
+	DescendRate float64 `json:"descend_rate"`
+
+	// HorizontalEnergy Battery energy in watt-hours per meter of level flight, defaults to 0
+	HorizontalEnergy *float64 `json:"horizontal_energy,omitempty"`
+	Id               string   `json:"id"`
+	Model            string   `json:"model"`
+
+	// Range Distance in meters flown on a single battery charge
+	Range int `json:"range"`
+
+	// Speed Horizontal speed in meters per second
+	Speed float64 `json:"speed"`
+}
+
+// DronePlanLeg defines model for DronePlanLeg.
+type DronePlanLeg struct {
+	// Distance Leg distance including its takeoff and landing
+	Distance int  `json:"distance"`
+	End      Plot `json:"end"`
+
+	// Estimate Flight time and battery energy of the registered drone given as drone_id
+	Estimate *FlightEstimate `json:"estimate,omitempty"`
+
+	// Landing Descent to the ground at the end plot
+	Landing int  `json:"landing"`
+	Start   Plot `json:"start"`
+
+	// Takeoff Ascent from the ground at the start plot
+	Takeoff int `json:"takeoff"`
+}
+
+// DronePlanProfile Distance of the plan compared with the same route flown following every tree and at a fixed altitude, given when a profile is requested
+type DronePlanProfile struct {
+	// FixedAltitudeDistance Distance at the altitude of the tallest tree over the highest ground
+	FixedAltitudeDistance int           `json:"fixed_altitude_distance"`
+	Name                  FlightProfile `json:"name"`
+
+	// SavedDistance Distance saved compared with following every tree, negative when the plan is longer
+	SavedDistance int `json:"saved_distance"`
+
+	// TerrainDistance Distance climbing and descending over every tree and the ground
+	TerrainDistance int `json:"terrain_distance"`
+}
+
+// DronePlanSection defines model for DronePlanSection.
+type DronePlanSection struct {
+	Distance int  `json:"distance"`
+	End      Plot `json:"end"`
+
+	// Estimate Flight time and battery energy of the registered drone given as drone_id
+	Estimate *FlightEstimate `json:"estimate,omitempty"`
+	Legs     *[]DronePlanLeg `json:"legs,omitempty"`
+	Rests    *[]Plot         `json:"rests,omitempty"`
+	Start    Plot            `json:"start"`
+
+	// TotalDistance Distance including the landing and takeoff of every rest
+	TotalDistance *int                 `json:"total_distance,omitempty"`
+	Waypoints     *[]DronePlanWaypoint `json:"waypoints,omitempty"`
+}
+
+// DronePlanStrategy defines model for DronePlanStrategy.
+type DronePlanStrategy string
+
+// DronePlanWaypoint defines model for DronePlanWaypoint.
+type DronePlanWaypoint struct {
+	// Altitude Meters above the reference level of the estate elevation grid, above the ground when the estate has none
+	Altitude           int `json:"altitude"`
+	CumulativeDistance int `json:"cumulative_distance"`
+
+	// RelativeAltitude Meters above the ground of the plot
+	RelativeAltitude int `json:"relative_altitude"`
+	X                int `json:"x"`
+	Y                int `json:"y"`
+}
+
+// DroneProfile Flight parameters of the drone in meters, every missing one defaults to 1
+type DroneProfile struct {
+	// Clearance Meters flown above the trees
+	Clearance *int `json:"clearance,omitempty"`
+
+	// CruiseFloor Lowest altitude flown over the plots
+	CruiseFloor *int `json:"cruise_floor,omitempty"`
+
+	// LandingAltitude Altitude from which the drone descends to land
+	LandingAltitude *int `json:"landing_altitude,omitempty"`
+
+	// TakeoffAltitude Altitude reached right after a takeoff
+	TakeoffAltitude *int `json:"takeoff_altitude,omitempty"`
+}
+
+// DroneRequest defines model for DroneRequest.
+type DroneRequest struct {
+	// Clearance Meters the drone must fly above the trees
+	Clearance int `json:"clearance"`
+
+	// ClimbEnergy Battery energy in watt-hours per meter climbed, defaults to 0
+	ClimbEnergy *float64 `json:"climb_energy,omitempty"`
+
+	// ClimbRate Vertical speed in meters per second when climbing
+	ClimbRate float64 `json:"climb_rate"`
+
+	// DescendEnergy Battery energy in watt-hours per meter descended, defaults to 0
+	DescendEnergy *float64 `json:"descend_energy,omitempty"`
+
+	// DescendRate Vertical speed in meters per second when descending
+	DescendRate float64 `json:"descend_rate"`
+
+	// HorizontalEnergy Battery energy in watt-hours per meter of level flight, defaults to 0
+	HorizontalEnergy *float64 `json:"horizontal_energy,omitempty"`
+	Model            string   `json:"model"`
+
+	// Range Distance in meters flown on a single battery charge
+	Range int `json:"range"`
+
+	// Speed Horizontal speed in meters per second
+	Speed float64 `json:"speed"`
+}
+
+// ElevationResponse defines model for ElevationResponse.
+type ElevationResponse struct {
+	// Highest Elevation in meters of the highest plot
+	Highest int `json:"highest"`
+
+	// Lowest Elevation in meters of the lowest plot
+	Lowest int `json:"lowest"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// EstateSummary defines model for EstateSummary.
+type EstateSummary struct {
+	// Area Number of plots
+	Area      int64     `json:"area"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        string    `json:"id"`
+	Length    int       `json:"length"`
+	PlotSize  int       `json:"plot_size"`
+
+	// TreeCount Number of trees inside the boundary of the estate
+	TreeCount int       `json:"tree_count"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Width     int       `json:"width"`
+}
+
+// FlightEstimate Flight time and battery energy of the registered drone given as drone_id
+type FlightEstimate struct {
+	DurationMinutes float64 `json:"duration_minutes"`
+
+	// EnergyWh Left out when the drone has no energy costs
+	EnergyWh *float64 `json:"energy_wh,omitempty"`
+}
+
+// FlightProfile defines model for FlightProfile.
+type FlightProfile string
+
 // GetEstateDronePlanResponse defines model for GetEstateDronePlanResponse.
 type GetEstateDronePlanResponse struct {
 	Distance int `json:"distance"`
-	Rest     *struct {
+
+	// Drones Plan of every drone when drones is given, distance and total_distance are then their sum
+	Drones *[]DronePlanSection `json:"drones,omitempty"`
+
+	// Estimate Flight time and battery energy of the registered drone given as drone_id
+	Estimate *FlightEstimate `json:"estimate,omitempty"`
+	Legs     *[]DronePlanLeg `json:"legs,omitempty"`
+
+	// Profile Distance of the plan compared with the same route flown following every tree and at a fixed altitude, given when a profile is requested
+	Profile *DronePlanProfile `json:"profile,omitempty"`
+	Rest    *struct {
 		X *int `json:"x,omitempty"`
 		Y *int `json:"y,omitempty"`
 	} `json:"rest,omitempty"`
+	Rests *[]Plot `json:"rests,omitempty"`
+
+	// Strategy Traversal strategy used for the plan
+	Strategy string `json:"strategy"`
+
+	// TotalDistance Distance including the landing and takeoff of every rest
+	TotalDistance *int                 `json:"total_distance,omitempty"`
+	Waypoints     *[]DronePlanWaypoint `json:"waypoints,omitempty"`
 }
 
 // GetEstateStatsResponse defines model for GetEstateStatsResponse.
 type GetEstateStatsResponse struct {
-	Count  int `json:"count"`
-	Max    int `json:"max"`
+	Count int `json:"count"`
+	Max   int `json:"max"`
+
+	// Median Median height, the mean of the two middle heights rounded down for an even count
 	Median int `json:"median"`
 	Min    int `json:"min"`
 }
 
+// GetPlotLocationResponse defines model for GetPlotLocationResponse.
+type GetPlotLocationResponse struct {
+	// Center WGS84 coordinate, as the origin of an estate it is the outer corner of plot (1, 1)
+	Center Location `json:"center"`
+
+	// Corners Corners of the plot, counterclockwise from the one nearest to the estate origin
+	Corners []Location `json:"corners"`
+	X       int        `json:"x"`
+	Y       int        `json:"y"`
+}
+
+// Home Plot where the drone takes off, lands and rests, it may be outside of the estate. Without it, the drone takes off from the first plot flown over and lands on the last one.
+type Home struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// LandingZone defines model for LandingZone.
+type LandingZone struct {
+	Id string `json:"id"`
+	X  int    `json:"x"`
+	Y  int    `json:"y"`
+}
+
+// ListDronesResponse defines model for ListDronesResponse.
+type ListDronesResponse struct {
+	Drones []Drone `json:"drones"`
+}
+
+// ListEstatesResponse defines model for ListEstatesResponse.
+type ListEstatesResponse struct {
+	Estates []EstateSummary `json:"estates"`
+
+	// NextCursor Cursor of the next page, missing on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// ListLandingZonesResponse defines model for ListLandingZonesResponse.
+type ListLandingZonesResponse struct {
+	LandingZones []LandingZone `json:"landing_zones"`
+}
+
+// ListRestrictedAreasResponse defines model for ListRestrictedAreasResponse.
+type ListRestrictedAreasResponse struct {
+	RestrictedAreas []RestrictedArea `json:"restricted_areas"`
+}
+
+// ListTreesResponse defines model for ListTreesResponse.
+type ListTreesResponse struct {
+	// NextCursor Cursor of the next page, missing on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+	Trees      []Tree  `json:"trees"`
+}
+
+// Location WGS84 coordinate, as the origin of an estate it is the outer corner of plot (1, 1)
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Plot defines model for Plot.
+type Plot struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// ResizeConflictResponse defines model for ResizeConflictResponse.
+type ResizeConflictResponse struct {
+	Message string `json:"message"`
+
+	// Trees Trees outside of the new size
+	Trees []Tree `json:"trees"`
+}
+
+// RestrictedArea defines model for RestrictedArea.
+type RestrictedArea struct {
+	// Height Height in meters of an obstacle, 0 for a no-fly area
+	Height int    `json:"height"`
+	Id     string `json:"id"`
+
+	// Kind Either no_fly or obstacle
+	Kind string `json:"kind"`
+
+	// Length Number of plots of the area along the length
+	Length int `json:"length"`
+
+	// Width Number of plots of the area along the width
+	Width int `json:"width"`
+
+	// X First plot of the area along the length
+	X int `json:"x"`
+
+	// Y First plot of the area along the width
+	Y int `json:"y"`
+}
+
+// SimulateDronePlanRequest defines model for SimulateDronePlanRequest.
+type SimulateDronePlanRequest struct {
+	// DroneId Drone to plan for, its range and clearance are used unless max_distance or clearance are given
+	DroneId *string `json:"drone_id,omitempty"`
+
+	// DroneProfile Flight parameters of the drone in meters, every missing one defaults to 1
+	DroneProfile *DroneProfile `json:"drone_profile,omitempty"`
+
+	// Drones Number of drones surveying the estate at once
+	Drones *int `json:"drones,omitempty"`
+
+	// EstateId Existing estate to overlay the trees onto, a tree replaces the one on its plot. Its size, trees, restricted areas, elevation and landing zones are used, so length, width and plot_size must be left out
+	EstateId *string `json:"estate_id,omitempty"`
+
+	// Length Length of the hypothetical estate, required without estate_id
+	Length *int `json:"length,omitempty"`
+
+	// MaxDip Number of plots of the longest dip flown straight over by the smooth profile, defaults to 3
+	MaxDip *int `json:"max_dip,omitempty"`
+
+	// MaxDistance Maximum distance of the drone
+	MaxDistance *int `json:"max_distance,omitempty"`
+
+	// PlotSize Length in meters of the side of a plot of the hypothetical estate, defaults to 10
+	PlotSize *int                `json:"plot_size,omitempty"`
+	Profile  *FlightProfile      `json:"profile,omitempty"`
+	Strategy *DronePlanStrategy  `json:"strategy,omitempty"`
+	Trees    []CreateTreeRequest `json:"trees"`
+
+	// Waypoints Include the waypoints of the route
+	Waypoints *bool `json:"waypoints,omitempty"`
+
+	// Width Width of the hypothetical estate, required without estate_id
+	Width *int `json:"width,omitempty"`
+}
+
+// SortOrder defines model for SortOrder.
+type SortOrder string
+
+// Tree defines model for Tree.
+type Tree struct {
+	CreatedAt time.Time `json:"created_at"`
+	Height    int       `json:"height"`
+	Id        string    `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+	X         int       `json:"x"`
+	Y         int       `json:"y"`
+}
+
+// TreeHeight defines model for TreeHeight.
+type TreeHeight struct {
+	Height     int       `json:"height"`
+	MeasuredAt time.Time `json:"measured_at"`
+}
+
+// TreeHistoryResponse defines model for TreeHistoryResponse.
+type TreeHistoryResponse struct {
+	Heights []TreeHeight `json:"heights"`
+}
+
+// UpdateBoundaryRequest defines model for UpdateBoundaryRequest.
+type UpdateBoundaryRequest struct {
+	// Boundary Polygon enclosing the plots of the estate, empty for a full rectangle
+	Boundary []Vertex `json:"boundary"`
+}
+
+// UpdateEstateRequest defines model for UpdateEstateRequest.
+type UpdateEstateRequest struct {
+	// DroneProfile Flight parameters of the drone in meters, every missing one defaults to 1
+	DroneProfile *DroneProfile `json:"drone_profile,omitempty"`
+
+	// Force Archives the trees outside of the new size and clears an elevation grid not covering it instead of rejecting the resize, defaults to false
+	Force  *bool `json:"force,omitempty"`
+	Length *int  `json:"length,omitempty"`
+	Width  *int  `json:"width,omitempty"`
+}
+
+// UpdateEstateResponse defines model for UpdateEstateResponse.
+type UpdateEstateResponse struct {
+	// ArchivedTrees Trees archived since they are outside of the new size
+	ArchivedTrees []Tree `json:"archived_trees"`
+
+	// ElevationCleared Whether the elevation grid was cleared since it does not cover the new size
+	ElevationCleared bool          `json:"elevation_cleared"`
+	Estate           EstateSummary `json:"estate"`
+}
+
+// UpdateTreeRequest defines model for UpdateTreeRequest.
+type UpdateTreeRequest struct {
+	Height int `json:"height"`
+}
+
+// Vertex Corner of the boundary of an estate, counted in plots from the outer corner of plot (1, 1)
+type Vertex struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Clearance defines model for Clearance.
+type Clearance = int
+
+// CruiseFloor defines model for CruiseFloor.
+type CruiseFloor = int
+
+// DroneId defines model for DroneId.
+type DroneId = string
+
+// LandingAltitude defines model for LandingAltitude.
+type LandingAltitude = int
+
+// MaxDip defines model for MaxDip.
+type MaxDip = int
+
+// MaxDistance defines model for MaxDistance.
+type MaxDistance = int
+
+// Profile defines model for Profile.
+type Profile = FlightProfile
+
+// Strategy defines model for Strategy.
+type Strategy = DronePlanStrategy
+
+// TakeoffAltitude defines model for TakeoffAltitude.
+type TakeoffAltitude = int
+
+// GetEstateParams defines parameters for GetEstate.
+type GetEstateParams struct {
+	// Cursor Position after which the page starts, from the next_cursor of the previous page (optional)
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Number of estates of the page (optional, defaults to 20)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort Sort key, area is the number of plots (optional, defaults to created_at)
+	Sort *GetEstateParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort order (optional, defaults to asc)
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// MinArea Lowest number of plots (optional)
+	MinArea *int `form:"min_area,omitempty" json:"min_area,omitempty"`
+
+	// MaxArea Highest number of plots (optional)
+	MaxArea *int `form:"max_area,omitempty" json:"max_area,omitempty"`
+
+	// MinTrees Lowest number of trees (optional)
+	MinTrees *int `form:"min_trees,omitempty" json:"min_trees,omitempty"`
+
+	// MaxTrees Highest number of trees (optional)
+	MaxTrees *int `form:"max_trees,omitempty" json:"max_trees,omitempty"`
+}
+
+// GetEstateParamsSort defines parameters for GetEstate.
+type GetEstateParamsSort string
+
+// GetEstateIdParams defines parameters for GetEstateId.
+type GetEstateIdParams struct {
+	// MaxDistance Maximum distance of the drone (optional)
+	MaxDistance *MaxDistance `form:"max_distance,omitempty" json:"max_distance,omitempty"`
+
+	// DroneId Drone to plan for, its range and clearance are used unless max_distance or clearance are given (optional)
+	DroneId *DroneId `form:"drone_id,omitempty" json:"drone_id,omitempty"`
+
+	// Strategy Order in which the plots are flown, trees only flies over the planted plots in a short tour and auto picks the shortest traversal of every plot (optional, defaults to row)
+	Strategy *Strategy `form:"strategy,omitempty" json:"strategy,omitempty"`
+
+	// Clearance Meters flown above the trees, overrides the estate drone profile (optional)
+	Clearance *Clearance `form:"clearance,omitempty" json:"clearance,omitempty"`
+
+	// TakeoffAltitude Altitude in meters reached right after a takeoff, overrides the estate drone profile (optional)
+	TakeoffAltitude *TakeoffAltitude `form:"takeoff_altitude,omitempty" json:"takeoff_altitude,omitempty"`
+
+	// LandingAltitude Altitude in meters from which the drone descends to land, overrides the estate drone profile (optional)
+	LandingAltitude *LandingAltitude `form:"landing_altitude,omitempty" json:"landing_altitude,omitempty"`
+
+	// CruiseFloor Lowest altitude in meters flown over the plots, overrides the estate drone profile (optional)
+	CruiseFloor *CruiseFloor `form:"cruise_floor,omitempty" json:"cruise_floor,omitempty"`
+
+	// Profile How closely the drone follows the trees and the ground, smooth flies straight over the short dips between higher plots and fixed flies at the altitude of the tallest tree over the highest ground (optional, defaults to terrain)
+	Profile *Profile `form:"profile,omitempty" json:"profile,omitempty"`
+
+	// MaxDip Number of plots of the longest dip flown straight over by the smooth profile (optional, defaults to 3)
+	MaxDip *MaxDip `form:"max_dip,omitempty" json:"max_dip,omitempty"`
+}
+
 // GetEstateIdDronePlanParams defines parameters for GetEstateIdDronePlan.
 type GetEstateIdDronePlanParams struct {
 	// MaxDistance Maximum distance of the drone (optional)
-	MaxDistance *int `form:"max_distance,omitempty" json:"max_distance,omitempty"`
+	MaxDistance *MaxDistance `form:"max_distance,omitempty" json:"max_distance,omitempty"`
+
+	// DroneId Drone to plan for, its range and clearance are used unless max_distance or clearance are given (optional)
+	DroneId *DroneId `form:"drone_id,omitempty" json:"drone_id,omitempty"`
+
+	// Include Extra detail to include in the plan (optional)
+	Include *GetEstateIdDronePlanParamsInclude `form:"include,omitempty" json:"include,omitempty"`
+
+	// Drones Number of drones surveying the estate at once, each one flies a contiguous section of about the same distance (optional)
+	Drones *int `form:"drones,omitempty" json:"drones,omitempty"`
+
+	// Strategy Order in which the plots are flown, trees only flies over the planted plots in a short tour and auto picks the shortest traversal of every plot (optional, defaults to row)
+	Strategy *Strategy `form:"strategy,omitempty" json:"strategy,omitempty"`
+
+	// Clearance Meters flown above the trees, overrides the estate drone profile (optional)
+	Clearance *Clearance `form:"clearance,omitempty" json:"clearance,omitempty"`
+
+	// TakeoffAltitude Altitude in meters reached right after a takeoff, overrides the estate drone profile (optional)
+	TakeoffAltitude *TakeoffAltitude `form:"takeoff_altitude,omitempty" json:"takeoff_altitude,omitempty"`
+
+	// LandingAltitude Altitude in meters from which the drone descends to land, overrides the estate drone profile (optional)
+	LandingAltitude *LandingAltitude `form:"landing_altitude,omitempty" json:"landing_altitude,omitempty"`
+
+	// CruiseFloor Lowest altitude in meters flown over the plots, overrides the estate drone profile (optional)
+	CruiseFloor *CruiseFloor `form:"cruise_floor,omitempty" json:"cruise_floor,omitempty"`
+
+	// Profile How closely the drone follows the trees and the ground, smooth flies straight over the short dips between higher plots and fixed flies at the altitude of the tallest tree over the highest ground (optional, defaults to terrain)
+	Profile *Profile `form:"profile,omitempty" json:"profile,omitempty"`
+
+	// MaxDip Number of plots of the longest dip flown straight over by the smooth profile (optional, defaults to 3)
+	MaxDip *MaxDip `form:"max_dip,omitempty" json:"max_dip,omitempty"`
+}
+
+// GetEstateIdDronePlanParamsInclude defines parameters for GetEstateIdDronePlan.
+type GetEstateIdDronePlanParamsInclude string
+
+// GetEstateIdDronePlanMissionParams defines parameters for GetEstateIdDronePlanMission.
+type GetEstateIdDronePlanMissionParams struct {
+	// Format QGroundControl .plan file or MAVLink .waypoints file
+	Format GetEstateIdDronePlanMissionParamsFormat `form:"format" json:"format"`
+
+	// MaxDistance Maximum distance of the drone (optional)
+	MaxDistance *MaxDistance `form:"max_distance,omitempty" json:"max_distance,omitempty"`
+
+	// DroneId Drone to plan for, its range and clearance are used unless max_distance or clearance are given (optional)
+	DroneId *DroneId `form:"drone_id,omitempty" json:"drone_id,omitempty"`
+
+	// Strategy Order in which the plots are flown, trees only flies over the planted plots in a short tour and auto picks the shortest traversal of every plot (optional, defaults to row)
+	Strategy *Strategy `form:"strategy,omitempty" json:"strategy,omitempty"`
+
+	// Clearance Meters flown above the trees, overrides the estate drone profile (optional)
+	Clearance *Clearance `form:"clearance,omitempty" json:"clearance,omitempty"`
+
+	// TakeoffAltitude Altitude in meters reached right after a takeoff, overrides the estate drone profile (optional)
+	TakeoffAltitude *TakeoffAltitude `form:"takeoff_altitude,omitempty" json:"takeoff_altitude,omitempty"`
+
+	// LandingAltitude Altitude in meters from which the drone descends to land, overrides the estate drone profile (optional)
+	LandingAltitude *LandingAltitude `form:"landing_altitude,omitempty" json:"landing_altitude,omitempty"`
+
+	// CruiseFloor Lowest altitude in meters flown over the plots, overrides the estate drone profile (optional)
+	CruiseFloor *CruiseFloor `form:"cruise_floor,omitempty" json:"cruise_floor,omitempty"`
+
+	// Profile How closely the drone follows the trees and the ground, smooth flies straight over the short dips between higher plots and fixed flies at the altitude of the tallest tree over the highest ground (optional, defaults to terrain)
+	Profile *Profile `form:"profile,omitempty" json:"profile,omitempty"`
+
+	// MaxDip Number of plots of the longest dip flown straight over by the smooth profile (optional, defaults to 3)
+	MaxDip *MaxDip `form:"max_dip,omitempty" json:"max_dip,omitempty"`
+}
+
+// GetEstateIdDronePlanMissionParamsFormat defines parameters for GetEstateIdDronePlanMission.
+type GetEstateIdDronePlanMissionParamsFormat string
+
+// GetEstateIdTreeParams defines parameters for GetEstateIdTree.
+type GetEstateIdTreeParams struct {
+	// Cursor Position after which the page starts, from the next_cursor of the previous page (optional)
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Number of trees of the page (optional, defaults to 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort Sort key, position sorts by x then y (optional, defaults to position)
+	Sort *GetEstateIdTreeParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort order (optional, defaults to asc)
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// MinHeight Lowest tree height (optional)
+	MinHeight *int `form:"min_height,omitempty" json:"min_height,omitempty"`
+
+	// MaxHeight Highest tree height (optional)
+	MaxHeight *int `form:"max_height,omitempty" json:"max_height,omitempty"`
+
+	// X1 Lowest plot position along the estate length (optional)
+	X1 *int `form:"x1,omitempty" json:"x1,omitempty"`
+
+	// X2 Highest plot position along the estate length (optional)
+	X2 *int `form:"x2,omitempty" json:"x2,omitempty"`
+
+	// Y1 Lowest plot position along the estate width (optional)
+	Y1 *int `form:"y1,omitempty" json:"y1,omitempty"`
+
+	// Y2 Highest plot position along the estate width (optional)
+	Y2 *int `form:"y2,omitempty" json:"y2,omitempty"`
+
+	// CreatedAfter Only the trees planted after this time (optional)
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
 }
 
+// GetEstateIdTreeParamsSort defines parameters for GetEstateIdTree.
+type GetEstateIdTreeParamsSort string
+
+// PostDroneJSONRequestBody defines body for PostDrone for application/json ContentType.
+type PostDroneJSONRequestBody = DroneRequest
+
+// PostDronePlanSimulateJSONRequestBody defines body for PostDronePlanSimulate for application/json ContentType.
+type PostDronePlanSimulateJSONRequestBody = SimulateDronePlanRequest
+
+// PutDroneIdJSONRequestBody defines body for PutDroneId for application/json ContentType.
+type PutDroneIdJSONRequestBody = DroneRequest
+
 // PostEstateJSONRequestBody defines body for PostEstate for application/json ContentType.
 type PostEstateJSONRequestBody = CreateEstateRequest
 
+// PatchEstateIdJSONRequestBody defines body for PatchEstateId for application/json ContentType.
+type PatchEstateIdJSONRequestBody = UpdateEstateRequest
+
+// PutEstateIdBoundaryJSONRequestBody defines body for PutEstateIdBoundary for application/json ContentType.
+type PutEstateIdBoundaryJSONRequestBody = UpdateBoundaryRequest
+
+// PostEstateIdLandingZoneJSONRequestBody defines body for PostEstateIdLandingZone for application/json ContentType.
+type PostEstateIdLandingZoneJSONRequestBody = CreateLandingZoneRequest
+
+// PostEstateIdRestrictedAreaJSONRequestBody defines body for PostEstateIdRestrictedArea for application/json ContentType.
+type PostEstateIdRestrictedAreaJSONRequestBody = CreateRestrictedAreaRequest
+
 // PostEstateIdTreeJSONRequestBody defines body for PostEstateIdTree for application/json ContentType.
 type PostEstateIdTreeJSONRequestBody = CreateTreeRequest
 
+// PatchEstateIdTreeTreeIdJSONRequestBody defines body for PatchEstateIdTreeTreeId for application/json ContentType.
+type PatchEstateIdTreeTreeIdJSONRequestBody = UpdateTreeRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List Drones
+	// (GET /drone)
+	GetDrone(ctx echo.Context) error
+	// Register A Drone
+	// (POST /drone)
+	PostDrone(ctx echo.Context) error
+	// Simulate A Drone Plan
+	// (POST /drone-plan/simulate)
+	PostDronePlanSimulate(ctx echo.Context) error
+	// Delete Drone
+	// (DELETE /drone/{id})
+	DeleteDroneId(ctx echo.Context, id string) error
+	// Get Drone
+	// (GET /drone/{id})
+	GetDroneId(ctx echo.Context, id string) error
+	// Update Drone
+	// (PUT /drone/{id})
+	PutDroneId(ctx echo.Context, id string) error
+	// List Estates
+	// (GET /estate)
+	GetEstate(ctx echo.Context, params GetEstateParams) error
 	// Endpoint Create /estate
 	// (POST /estate)
 	PostEstate(ctx echo.Context) error
+	// Delete Estate
+	// (DELETE /estate/{id})
+	DeleteEstateId(ctx echo.Context, id string) error
+	// Get Estate
+	// (GET /estate/{id})
+	GetEstateId(ctx echo.Context, id string, params GetEstateIdParams) error
+	// Update Estate
+	// (PATCH /estate/{id})
+	PatchEstateId(ctx echo.Context, id string) error
+	// Update Estate Boundary
+	// (PUT /estate/{id}/boundary)
+	PutEstateIdBoundary(ctx echo.Context, id string) error
 	// Get Estate Drone Plan
 	// (GET /estate/{id}/drone-plan)
 	GetEstateIdDronePlan(ctx echo.Context, id string, params GetEstateIdDronePlanParams) error
+	// Export Estate Drone Plan As A Mission File
+	// (GET /estate/{id}/drone-plan/mission)
+	GetEstateIdDronePlanMission(ctx echo.Context, id string, params GetEstateIdDronePlanMissionParams) error
+	// Upload Estate Elevation Grid
+	// (PUT /estate/{id}/elevation)
+	PutEstateIdElevation(ctx echo.Context, id string) error
+	// List Landing Zones Within Estate
+	// (GET /estate/{id}/landing-zone)
+	GetEstateIdLandingZone(ctx echo.Context, id string) error
+	// Register A Landing Zone Within Estate
+	// (POST /estate/{id}/landing-zone)
+	PostEstateIdLandingZone(ctx echo.Context, id string) error
+	// Delete Landing Zone
+	// (DELETE /estate/{id}/landing-zone/{zoneId})
+	DeleteEstateIdLandingZoneZoneId(ctx echo.Context, id string, zoneId string) error
+	// Get Landing Zone
+	// (GET /estate/{id}/landing-zone/{zoneId})
+	GetEstateIdLandingZoneZoneId(ctx echo.Context, id string, zoneId string) error
+	// Get Plot Location
+	// (GET /estate/{id}/plot/{x}/{y})
+	GetEstateIdPlotXY(ctx echo.Context, id string, x int, y int) error
+	// Restore Estate
+	// (POST /estate/{id}/restore)
+	PostEstateIdRestore(ctx echo.Context, id string) error
+	// List Restricted Areas Within Estate
+	// (GET /estate/{id}/restricted-area)
+	GetEstateIdRestrictedArea(ctx echo.Context, id string) error
+	// Mark A Restricted Area Within Estate
+	// (POST /estate/{id}/restricted-area)
+	PostEstateIdRestrictedArea(ctx echo.Context, id string) error
+	// Delete Restricted Area
+	// (DELETE /estate/{id}/restricted-area/{areaId})
+	DeleteEstateIdRestrictedAreaAreaId(ctx echo.Context, id string, areaId string) error
+	// Get Restricted Area
+	// (GET /estate/{id}/restricted-area/{areaId})
+	GetEstateIdRestrictedAreaAreaId(ctx echo.Context, id string, areaId string) error
 	// Get Estate Stats
 	// (GET /estate/{id}/stats)
 	GetEstateIdStats(ctx echo.Context, id string) error
+	// List Trees Within Estate
+	// (GET /estate/{id}/tree)
+	GetEstateIdTree(ctx echo.Context, id string, params GetEstateIdTreeParams) error
 	// Create Tree Within Estate
 	// (POST /estate/{id}/tree)
 	PostEstateIdTree(ctx echo.Context, id string) error
+	// Delete Tree
+	// (DELETE /estate/{id}/tree/{treeId})
+	DeleteEstateIdTreeTreeId(ctx echo.Context, id string, treeId string) error
+	// Get Tree
+	// (GET /estate/{id}/tree/{treeId})
+	GetEstateIdTreeTreeId(ctx echo.Context, id string, treeId string) error
+	// Update Tree
+	// (PATCH /estate/{id}/tree/{treeId})
+	PatchEstateIdTreeTreeId(ctx echo.Context, id string, treeId string) error
+	// Get Tree Height History
+	// (GET /estate/{id}/tree/{treeId}/history)
+	GetEstateIdTreeTreeIdHistory(ctx echo.Context, id string, treeId string) error
+	// Restore Tree
+	// (POST /estate/{id}/tree/{treeId}/restore)
+	PostEstateIdTreeTreeIdRestore(ctx echo.Context, id string, treeId string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -91,17 +885,35 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// PostEstate converts echo context to params.
-func (w *ServerInterfaceWrapper) PostEstate(ctx echo.Context) error {
+// GetDrone converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDrone(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostEstate(ctx)
+	err = w.Handler.GetDrone(ctx)
 	return err
 }
 
-// GetEstateIdDronePlan converts echo context to params.
-func (w *ServerInterfaceWrapper) GetEstateIdDronePlan(ctx echo.Context) error {
+// PostDrone converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDrone(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostDrone(ctx)
+	return err
+}
+
+// PostDronePlanSimulate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDronePlanSimulate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostDronePlanSimulate(ctx)
+	return err
+}
+
+// DeleteDroneId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDroneId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id string
@@ -111,17 +923,652 @@ func (w *ServerInterfaceWrapper) GetEstateIdDronePlan(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEstateIdDronePlanParams
-	// ------------- Optional query parameter "max_distance" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDroneId(ctx, id)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "max_distance", ctx.QueryParams(), &params.MaxDistance)
-	if err != nil {
+// GetDroneId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDroneId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDroneId(ctx, id)
+	return err
+}
+
+// PutDroneId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutDroneId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutDroneId(ctx, id)
+	return err
+}
+
+// GetEstate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstate(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEstateParams
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "min_area" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_area", ctx.QueryParams(), &params.MinArea)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_area: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_area" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_area", ctx.QueryParams(), &params.MaxArea)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_area: %s", err))
+	}
+
+	// ------------- Optional query parameter "min_trees" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_trees", ctx.QueryParams(), &params.MinTrees)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_trees: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_trees" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_trees", ctx.QueryParams(), &params.MaxTrees)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_trees: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstate(ctx, params)
+	return err
+}
+
+// PostEstate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEstate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostEstate(ctx)
+	return err
+}
+
+// DeleteEstateId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteEstateId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteEstateId(ctx, id)
+	return err
+}
+
+// GetEstateId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEstateIdParams
+	// ------------- Optional query parameter "max_distance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_distance", ctx.QueryParams(), &params.MaxDistance)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_distance: %s", err))
+	}
+
+	// ------------- Optional query parameter "drone_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "drone_id", ctx.QueryParams(), &params.DroneId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter drone_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "strategy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strategy", ctx.QueryParams(), &params.Strategy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strategy: %s", err))
+	}
+
+	// ------------- Optional query parameter "clearance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clearance", ctx.QueryParams(), &params.Clearance)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clearance: %s", err))
+	}
+
+	// ------------- Optional query parameter "takeoff_altitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "takeoff_altitude", ctx.QueryParams(), &params.TakeoffAltitude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter takeoff_altitude: %s", err))
+	}
+
+	// ------------- Optional query parameter "landing_altitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "landing_altitude", ctx.QueryParams(), &params.LandingAltitude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter landing_altitude: %s", err))
+	}
+
+	// ------------- Optional query parameter "cruise_floor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cruise_floor", ctx.QueryParams(), &params.CruiseFloor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cruise_floor: %s", err))
+	}
+
+	// ------------- Optional query parameter "profile" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "profile", ctx.QueryParams(), &params.Profile)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profile: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_dip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_dip", ctx.QueryParams(), &params.MaxDip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_dip: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateId(ctx, id, params)
+	return err
+}
+
+// PatchEstateId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchEstateId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchEstateId(ctx, id)
+	return err
+}
+
+// PutEstateIdBoundary converts echo context to params.
+func (w *ServerInterfaceWrapper) PutEstateIdBoundary(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutEstateIdBoundary(ctx, id)
+	return err
+}
+
+// GetEstateIdDronePlan converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateIdDronePlan(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEstateIdDronePlanParams
+	// ------------- Optional query parameter "max_distance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_distance", ctx.QueryParams(), &params.MaxDistance)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_distance: %s", err))
+	}
+
+	// ------------- Optional query parameter "drone_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "drone_id", ctx.QueryParams(), &params.DroneId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter drone_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", ctx.QueryParams(), &params.Include)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include: %s", err))
+	}
+
+	// ------------- Optional query parameter "drones" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "drones", ctx.QueryParams(), &params.Drones)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter drones: %s", err))
+	}
+
+	// ------------- Optional query parameter "strategy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strategy", ctx.QueryParams(), &params.Strategy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strategy: %s", err))
+	}
+
+	// ------------- Optional query parameter "clearance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clearance", ctx.QueryParams(), &params.Clearance)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clearance: %s", err))
+	}
+
+	// ------------- Optional query parameter "takeoff_altitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "takeoff_altitude", ctx.QueryParams(), &params.TakeoffAltitude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter takeoff_altitude: %s", err))
+	}
+
+	// ------------- Optional query parameter "landing_altitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "landing_altitude", ctx.QueryParams(), &params.LandingAltitude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter landing_altitude: %s", err))
+	}
+
+	// ------------- Optional query parameter "cruise_floor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cruise_floor", ctx.QueryParams(), &params.CruiseFloor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cruise_floor: %s", err))
+	}
+
+	// ------------- Optional query parameter "profile" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "profile", ctx.QueryParams(), &params.Profile)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profile: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_dip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_dip", ctx.QueryParams(), &params.MaxDip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_dip: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateIdDronePlan(ctx, id, params)
+	return err
+}
+
+// GetEstateIdDronePlanMission converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateIdDronePlanMission(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEstateIdDronePlanMissionParams
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_distance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_distance", ctx.QueryParams(), &params.MaxDistance)
+	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_distance: %s", err))
 	}
 
+	// ------------- Optional query parameter "drone_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "drone_id", ctx.QueryParams(), &params.DroneId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter drone_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "strategy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strategy", ctx.QueryParams(), &params.Strategy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strategy: %s", err))
+	}
+
+	// ------------- Optional query parameter "clearance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clearance", ctx.QueryParams(), &params.Clearance)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clearance: %s", err))
+	}
+
+	// ------------- Optional query parameter "takeoff_altitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "takeoff_altitude", ctx.QueryParams(), &params.TakeoffAltitude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter takeoff_altitude: %s", err))
+	}
+
+	// ------------- Optional query parameter "landing_altitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "landing_altitude", ctx.QueryParams(), &params.LandingAltitude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter landing_altitude: %s", err))
+	}
+
+	// ------------- Optional query parameter "cruise_floor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cruise_floor", ctx.QueryParams(), &params.CruiseFloor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cruise_floor: %s", err))
+	}
+
+	// ------------- Optional query parameter "profile" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "profile", ctx.QueryParams(), &params.Profile)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profile: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_dip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_dip", ctx.QueryParams(), &params.MaxDip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_dip: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateIdDronePlanMission(ctx, id, params)
+	return err
+}
+
+// PutEstateIdElevation converts echo context to params.
+func (w *ServerInterfaceWrapper) PutEstateIdElevation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutEstateIdElevation(ctx, id)
+	return err
+}
+
+// GetEstateIdLandingZone converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateIdLandingZone(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateIdLandingZone(ctx, id)
+	return err
+}
+
+// PostEstateIdLandingZone converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEstateIdLandingZone(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostEstateIdLandingZone(ctx, id)
+	return err
+}
+
+// DeleteEstateIdLandingZoneZoneId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteEstateIdLandingZoneZoneId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "zoneId" -------------
+	var zoneId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "zoneId", ctx.Param("zoneId"), &zoneId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter zoneId: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetEstateIdDronePlan(ctx, id, params)
+	err = w.Handler.DeleteEstateIdLandingZoneZoneId(ctx, id, zoneId)
+	return err
+}
+
+// GetEstateIdLandingZoneZoneId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateIdLandingZoneZoneId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "zoneId" -------------
+	var zoneId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "zoneId", ctx.Param("zoneId"), &zoneId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter zoneId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateIdLandingZoneZoneId(ctx, id, zoneId)
+	return err
+}
+
+// GetEstateIdPlotXY converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateIdPlotXY(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "x" -------------
+	var x int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "x", ctx.Param("x"), &x, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x: %s", err))
+	}
+
+	// ------------- Path parameter "y" -------------
+	var y int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "y", ctx.Param("y"), &y, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter y: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateIdPlotXY(ctx, id, x, y)
+	return err
+}
+
+// PostEstateIdRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEstateIdRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostEstateIdRestore(ctx, id)
+	return err
+}
+
+// GetEstateIdRestrictedArea converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateIdRestrictedArea(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateIdRestrictedArea(ctx, id)
+	return err
+}
+
+// PostEstateIdRestrictedArea converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEstateIdRestrictedArea(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostEstateIdRestrictedArea(ctx, id)
+	return err
+}
+
+// DeleteEstateIdRestrictedAreaAreaId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteEstateIdRestrictedAreaAreaId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "areaId" -------------
+	var areaId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", ctx.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter areaId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteEstateIdRestrictedAreaAreaId(ctx, id, areaId)
+	return err
+}
+
+// GetEstateIdRestrictedAreaAreaId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateIdRestrictedAreaAreaId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "areaId" -------------
+	var areaId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", ctx.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter areaId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateIdRestrictedAreaAreaId(ctx, id, areaId)
 	return err
 }
 
@@ -141,6 +1588,101 @@ func (w *ServerInterfaceWrapper) GetEstateIdStats(ctx echo.Context) error {
 	return err
 }
 
+// GetEstateIdTree converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateIdTree(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEstateIdTreeParams
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "min_height" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_height", ctx.QueryParams(), &params.MinHeight)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_height: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_height" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_height", ctx.QueryParams(), &params.MaxHeight)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_height: %s", err))
+	}
+
+	// ------------- Optional query parameter "x1" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "x1", ctx.QueryParams(), &params.X1)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x1: %s", err))
+	}
+
+	// ------------- Optional query parameter "x2" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "x2", ctx.QueryParams(), &params.X2)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter x2: %s", err))
+	}
+
+	// ------------- Optional query parameter "y1" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "y1", ctx.QueryParams(), &params.Y1)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter y1: %s", err))
+	}
+
+	// ------------- Optional query parameter "y2" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "y2", ctx.QueryParams(), &params.Y2)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter y2: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_after: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateIdTree(ctx, id, params)
+	return err
+}
+
 // PostEstateIdTree converts echo context to params.
 func (w *ServerInterfaceWrapper) PostEstateIdTree(ctx echo.Context) error {
 	var err error
@@ -157,6 +1699,126 @@ func (w *ServerInterfaceWrapper) PostEstateIdTree(ctx echo.Context) error {
 	return err
 }
 
+// DeleteEstateIdTreeTreeId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteEstateIdTreeTreeId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "treeId" -------------
+	var treeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "treeId", ctx.Param("treeId"), &treeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter treeId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteEstateIdTreeTreeId(ctx, id, treeId)
+	return err
+}
+
+// GetEstateIdTreeTreeId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateIdTreeTreeId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "treeId" -------------
+	var treeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "treeId", ctx.Param("treeId"), &treeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter treeId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateIdTreeTreeId(ctx, id, treeId)
+	return err
+}
+
+// PatchEstateIdTreeTreeId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchEstateIdTreeTreeId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "treeId" -------------
+	var treeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "treeId", ctx.Param("treeId"), &treeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter treeId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchEstateIdTreeTreeId(ctx, id, treeId)
+	return err
+}
+
+// GetEstateIdTreeTreeIdHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEstateIdTreeTreeIdHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "treeId" -------------
+	var treeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "treeId", ctx.Param("treeId"), &treeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter treeId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEstateIdTreeTreeIdHistory(ctx, id, treeId)
+	return err
+}
+
+// PostEstateIdTreeTreeIdRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEstateIdTreeTreeIdRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "treeId" -------------
+	var treeId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "treeId", ctx.Param("treeId"), &treeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter treeId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostEstateIdTreeTreeIdRestore(ctx, id, treeId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -185,30 +1847,159 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/drone", wrapper.GetDrone)
+	router.POST(baseURL+"/drone", wrapper.PostDrone)
+	router.POST(baseURL+"/drone-plan/simulate", wrapper.PostDronePlanSimulate)
+	router.DELETE(baseURL+"/drone/:id", wrapper.DeleteDroneId)
+	router.GET(baseURL+"/drone/:id", wrapper.GetDroneId)
+	router.PUT(baseURL+"/drone/:id", wrapper.PutDroneId)
+	router.GET(baseURL+"/estate", wrapper.GetEstate)
 	router.POST(baseURL+"/estate", wrapper.PostEstate)
+	router.DELETE(baseURL+"/estate/:id", wrapper.DeleteEstateId)
+	router.GET(baseURL+"/estate/:id", wrapper.GetEstateId)
+	router.PATCH(baseURL+"/estate/:id", wrapper.PatchEstateId)
+	router.PUT(baseURL+"/estate/:id/boundary", wrapper.PutEstateIdBoundary)
 	router.GET(baseURL+"/estate/:id/drone-plan", wrapper.GetEstateIdDronePlan)
+	router.GET(baseURL+"/estate/:id/drone-plan/mission", wrapper.GetEstateIdDronePlanMission)
+	router.PUT(baseURL+"/estate/:id/elevation", wrapper.PutEstateIdElevation)
+	router.GET(baseURL+"/estate/:id/landing-zone", wrapper.GetEstateIdLandingZone)
+	router.POST(baseURL+"/estate/:id/landing-zone", wrapper.PostEstateIdLandingZone)
+	router.DELETE(baseURL+"/estate/:id/landing-zone/:zoneId", wrapper.DeleteEstateIdLandingZoneZoneId)
+	router.GET(baseURL+"/estate/:id/landing-zone/:zoneId", wrapper.GetEstateIdLandingZoneZoneId)
+	router.GET(baseURL+"/estate/:id/plot/:x/:y", wrapper.GetEstateIdPlotXY)
+	router.POST(baseURL+"/estate/:id/restore", wrapper.PostEstateIdRestore)
+	router.GET(baseURL+"/estate/:id/restricted-area", wrapper.GetEstateIdRestrictedArea)
+	router.POST(baseURL+"/estate/:id/restricted-area", wrapper.PostEstateIdRestrictedArea)
+	router.DELETE(baseURL+"/estate/:id/restricted-area/:areaId", wrapper.DeleteEstateIdRestrictedAreaAreaId)
+	router.GET(baseURL+"/estate/:id/restricted-area/:areaId", wrapper.GetEstateIdRestrictedAreaAreaId)
 	router.GET(baseURL+"/estate/:id/stats", wrapper.GetEstateIdStats)
+	router.GET(baseURL+"/estate/:id/tree", wrapper.GetEstateIdTree)
 	router.POST(baseURL+"/estate/:id/tree", wrapper.PostEstateIdTree)
+	router.DELETE(baseURL+"/estate/:id/tree/:treeId", wrapper.DeleteEstateIdTreeTreeId)
+	router.GET(baseURL+"/estate/:id/tree/:treeId", wrapper.GetEstateIdTreeTreeId)
+	router.PATCH(baseURL+"/estate/:id/tree/:treeId", wrapper.PatchEstateIdTreeTreeId)
+	router.GET(baseURL+"/estate/:id/tree/:treeId/history", wrapper.GetEstateIdTreeTreeIdHistory)
+	router.POST(baseURL+"/estate/:id/tree/:treeId/restore", wrapper.PostEstateIdTreeTreeIdRestore)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xWXU/rRhD9K6tpH1rJxQ5QCfmxhaI8UCE+1AeEqsU7iRfZu2Z3nCZC+e/V7toOcZyQ",
-	"Ui7oXt23VXJm58zZMzN+hkyXlVaoyEL6DDbLseT++LtBTnhmiRNe4VONltzPldEVGpLoQQWqKeXuhHNe",
-	"VgVCOkoiKKWSZV1COoqAFhVCClIRTtHAMoJ/pPiPMcsIDD7V0qCA9K7N2t503wXoh0fMyOUI7K/QVlpZ",
-	"3CQuxRoBmKJCwwnF7e34dHYM3ZWWjFTTDQpS7Eh7Y3C7ZDnKaU5r2Y+SIZnmGxJtYhavYnq85+CCopbF",
-	"UA1nxmizXbkSreVT/8duiVrgUI5zpOCsU6MVXhZcbU8opCWuMlwr9TAZ1MMMSv4GJQeF7JXRq7fjubPg",
-	"a+Jktxeb6Vqtm2OQbcnne4BQSK72wMlXQb1SA8tAI8R32TaLd8FSTbSfFzLDpm7FS4e6GN84DiTJ96Gz",
-	"AnGS2l05Q2PdKYXRQXKQOJyuUPFKQgpH/qcIKk65ly5GL7DXVAcbOGX9ZWPh7ta2eQQI5aCl37RYBN0V",
-	"YVCeV1UhMx8WP1qtVnPRnX40OIEUfohXgzNupmY8NDKX69qRqTH41BvAEz9MRu9MofOXzy7QZkZWFKS8",
-	"QqtrkyHLPFQwW2cZWjupi2LhJD5Oknejsz5LBtiM1YwXUrDmOdiDe49lBL8GEn0woVG8YNdoZmiYv92b",
-	"09Zlyc0CUjhTotJSEQtKsNYVDtWc42cplrFwk+eXqggdMsUBw3RNOxbdnPKOM7xEQmMhvetzDAFsfArO",
-	"9ZB6f0LU2l0K6NsheqHlxkTtX3/B525LsnbYMD1hlCPz1bCftMfx4uc2+1ONZrFKX/L5392cGki8avj7",
-	"DZO+nyt2TP8Bi1wHf7JzJNao6+OYf47PMuzlygSewvHHUWhE+FMT+0PXSvyvftmiar9b3NHu0yh+v31s",
-	"k3yIVdf39is2DSJ8K75oqulbggzutWzHwn0Pf4IlvtSCf/l5/329v2m9f7190XxWOA+wvyTlUjVtEjJb",
-	"HxkMXpsCUsiJqjSOC53xIteW0pPkJIHl/fLfAAAA//+o/d6mfA8AAA==",
+	"H4sIAAAAAAAC/+xd3XPcNpL/V1C8e9itoyWNrOw6elP8FV05sc/2bu42m1JBZM8M1hxgFgAlTVz636/Q",
+	"AEiCBD9GGn0k0YvLkkCg0Wg0un/daHxNMrFaCw5cq+T4a7Kmkq5Ag8SfXhZAJeUZmB9yUJlka80ET46T",
+	"H7ARmRfikhN6Li6A6CUQLQFUSsQFSMlyUPhLUJpqILkUHMhaijkrgPxJYFe0+HOSJsx0+e8S5CZJE05X",
+	"kBwnWTV4mqhsCStqqFgxzlblKjk+SBO9WZuGjGtYgEyur9PkpSyZgjeFELJL8ztxCUoTWmimyxwI42TV",
+	"nIahGgleF0LvahZI0NkcKYpOZBadyCszzGnenQT+gWhB1gXlZC5kSphWRFK+AEJ5Tiq+ESqBlApyUvIC",
+	"lCIrenWWM6Xxj0K2Wi7YBfDxCeH8z1geTMZNQGnJ+ALpf0d5zvjixPG6O4+TyCpIsSKXS5YtkeGW0+Yr",
+	"4LkyUy4oz3ezLIUl78zLwjZL8wO9esXW3Rn9WK7OQRIxt/Jj/mMILARfGLHL2drJmdKSssVSW4E732Az",
+	"tRJCL7szSEkOc1oWGjnwvG9Cdm3XW8/DSkNkf9Mr8y2p5WXeWJRR/jZFLSYpTTo+2Cl3afheXJKsEAqK",
+	"TWPsuSgKcalqdYNSb35aSFEaAXGsnBcMVIvZyOmlkLgcipyDvgTgZMkWS5Bu3Ux3c3YFueuBavysUhuO",
+	"E5oWhVlXQ0PdOfaktKOlbxk1SEkZ7+OeE4KAcf8pYZ4cJ/+xXyvsfftXtf+mMDP0fDQ8/aQl1bDYdJn6",
+	"XuYgzaarN5qbtgQrn6ljq+DFxrGgoRkp15C7Txgn1LFTi1Ii52hpdBPLvqia2ZZN9AKkooXhH1yA3GAn",
+	"fRyS4rKPO8rPbSp7UGV+KCivuGJY9Jl+ATGfb6WhJNBsCTmRKFF0rkESSrTtaTeayXV2A8107Vvi2f2d",
+	"kT8qNx9BrQVXOL+1FGuQmgG2OHctzP+ZhpUa4+PfQWq4Sq6rsamUdGN+RnGYrBEtY5I0gSu6WpudPzuI",
+	"H+cS/l0yCXly/HNNrh/ul+oTcf4vyHSC5z9QDa9xgI/w7xKUHp54SPAHUWwWghPgRu8wvmjsDzEnlPtF",
+	"1UuqCVOEC00omZdFQSRkmvJFASmhVrSZImsqdThtcrkEjkd2BtzIDzP7SDFc6Vsugz2b17U+Hd8VXmek",
+	"yVKsRr/53rS5TpMC+EIvTevGEqaD4pkmQrIF42NDvBMZxdVwYnWm2K+RzfkOKWhsTcdkw0jzf7sEoU6Z",
+	"HSTpNgRLoS0pXSMMFqgiM1GaNcwKkX25ZAqsCQNUtRfdMiwkJ6DmIE3mQq6oTo6TXJTnqP1X9hBOjp//",
+	"pUlsvVc4bjBD6yXLt1yQ1vZyS+p76t9czrL7h+D9O+yqQ0mXu5vRNi0KrxLzUT9h/ZqO5cFYyQI4SKoh",
+	"/9vfTl9dHCVpxIBtjszy4WG1ZJmG/EQC7WXJEsyZETFy8PehIFNOxLnSNDPKxBNibP3q1/Z0bgrQ0Tcx",
+	"Jn9h3M6dGyn4OeHibI7f+Y4aE/OTb27vSfqcSqCEGjsXf/SyPjOmrRP4YOPFCK3k9yYj4sdbDnjVHewN",
+	"k0pb1T00s7YS6RHtLfu2DEi32hG4uunoxvgsASbIZTXy84Neht3Nnk49FbE54Dll+qVF8X6eHP98P7t7",
+	"wuHpeXr9iyfTGJnvYNHlct7raL2DRe1kMZ4VpdGuaCA4UxDNauewNgXk2xexJQC74YeI/1AI5CwozVZU",
+	"wzT34rVvbRSEoyZyMKoMuEYfp/LIvAcF3DoOo3tTaSr11Fk4LkXsd0sKHsldYnCMKeS0pMXSZvmcJg0f",
+	"19NRc6dXmo2Y9Hq9r1oeN+I8Zv7UHAKXTFunTdEVEClK7bw25xcb2bHeFfql1E6YOofWOxWpQ3vQFqWV",
+	"c8IUnjagNJjJhSKMPVRuyVm/RFcTuIXjHCrCw5iQWH9pK8c4TRS9gHwK7diwxfYYh1PCYUE1u3CGfbVi",
+	"TFnkRzanchjVmg4NmEJWVrDVuaHALKyDxsyPyMbWutcyH3Dz6HBUxpG1EbrSXiHocHZQ9j9B5u3qfjX5",
+	"KPQcLNRk9zg4ASLemQSlp/dWqbdWL9vpRqFpMUWu6oMH7RyrwKwUuUOoQm3MNEZ99zS5pJu1YFzfgH8/",
+	"uU+705+mi4dlr4GMeatYisskTTJRlCtuRHnNJC2SNEEcLEkTg2lFDeUuyR2Rpr34kguh1METCXOQYJaj",
+	"gAsoWi4kFHCB7ihZSJanje/cwVbpH/fBkirCBYfR4zYrV2WBOuwsugGjH0lwn2wxQUdodbCJiWb6cJPN",
+	"WJMem7OBsHUnE+dKv2T1neZWp5A6rhYC6ZXbl7rdtWIKcScb+mhgF53zOLtZcK7F8aF4WhpGr0bjabEo",
+	"Wt9w0ZXsRGX6MdlJsaKtBu8Ar/2DD8LAWwx63SdOvd7a+KLX3FiVSpN5sRmSgMNRCTAGxxlwkLFgwndU",
+	"ayO19u8YVaBaP1uKUiqyBmmF21otkA/BX3tHUQBsCPKypEl3sIeEGYyUZbQgag2QN9AVQ5SCTHh96Q2q",
+	"AEqJkdIZ3gnbbXnjuhnhzsE3W7PH03dLBtVGZpOi55NYtBSS/Sq4sUFuySUxd2fiHPXpIK9mW7NqJXIo",
+	"QhDh1X+fkpOFpIp8PjpIIkc/BtwHLapWcgHGypgJEJBzN+lsSeUiOJ+PDg5GYWlcsVi01DN7YE3bANPo",
+	"IrbOTsspP3tPS7AXW5KXNnRW7Ph87c2afvjWuYbdKVffduMA7puukRF1Jgpxue0A9pNu/+OYlxssreYV",
+	"ZYuUQvazZAVKUSt/w+CWbxgdA+3ET+Vq5YJh4RhUAh1FZDueQCVRjOu/HCVdbqRJhqhkfkaR47UIUg3P",
+	"NFtBbLttDfL1Bqm69ARhppG2WgKcYdhniDU2gG7DeigtPuTYHwKNjlau8605FY8Ejcgk5vO0QkBNxqRW",
+	"GoL5BwsZ0BoTtpZz3Wcom1mh33keHgyObxIWTGmQkDsjx+JYVJFGXlILUyglbuGzFeOlBhWy5nDvm0lH",
+	"mSXj7HIZQ3DnmohS1w6YJc36X34CmVDhbjl83mP0DGvgznT6md1wSryn6yCdJE1skoyHdKLe7VvQVkVU",
+	"bm6/Qoo6jodxaADZE0kXMCPUOINlorVB8AMDqeFypzVgjghFAHJgHot2K8EkUeVqamC9g09F4JdHgiVt",
+	"FeNvoM0OhtpJxHaaP7Mr1Ksvn+lzlVfk29icRxMt9WBsc9s5sKcj7PcBlR0+/8tDQWVNpNbzMqY5qj3/",
+	"SVOt+vd7dQQOH2ArejWhEeSM8phHa35PbGwwRXavwKoI8399KciK5XkBrokiiC+Zs8EGRCRm7JgDwp9Y",
+	"I4TY9JShRi22+n7NPO331XR6uGsk3Oe4DLAXuAa5Ta5MJiR3adshF1/aPzRRt7QnccX8XXAgHKgEVUXx",
+	"HJ7o8ncmKtMmbe3dfIegnmNczY/YMnzvUp3ax4/AM9yeH+78MXva8G6e4la32aGo01LCNFnRDTkHc/r7",
+	"xKOaYXvkJ6aXxjBgOo11WTN9XmcHNEA0H/dVxnm0ykZps0B7HSNnwi67GUdj7GtkAO0g1+ZOxIEN50W8",
+	"Y0qjDh3QcLWVMl0lj6th22kfTVb1DhBlJWs6VaGLF9mKHK70WVZKFQN4X+LvvVSbpmRNF5A2UOpaLs1f",
+	"RnMtPP19DGiI1gAXPE7861Yr1Oh7dJ3CEfqoDVO/BgiWVcMzalpOpjkcYZTszjh9lJuUoAF671oqfGht",
+	"KhsMuaOTt11GZ+zPos5cfnr76cURyYSQOeNUQ2pcSb30h12Y9MswpRf/WiKsjUeMh0LIn2Ypmf25o5oL",
+	"aqMGgQZ7drD31xffHv71m8G0z2+bWOCzb2PQpckx6HY/mz3f+/Zw9vzwr4P9z14EA8xedEfo7IsqPlaP",
+	"HGM5GvJjXsbdHlMfwUAXLwWfFyzTN8HSGmLadjrwgkR46nO4JA4s2Z1Qe+LSAfFuKYndpZ4eWBuacPEM",
+	"40gWAho2M25w/vsk1Rb2yvQSJLEZq6SR+9okoU5ovZMk1ntIW/1DJKqiORZkq0bQxoEc0E8MY/FNIKon",
+	"QFrBgPd8cTImgbe6jtEHktWiZVsQVcoL2Hg8wh1VVBPBs1DZj0eW7MdR7r2+YkqbQdwAWqCPUtBN4yKe",
+	"4FqY2y/mJyJhXdDM3YUyrBf22osRrT1yqhUqy9RfGa5NFxQ4lTYSXhoJsAQtsmqFUqJElXCOgoSNK+Da",
+	"BsHPzY6wIO02msLdMfERpc1a6CXYqKnlQiM5/9K5ejULt7pq4q9v3uml0tZV0mQaUTe6IZqMxllucp1n",
+	"cCluc9Nn4h7tppM2YMktbyBuawJ3U/kj3lyAJIYcPkXY0kIbVTPPUMwjrtfsXIgCKB844H4yv76HnTHZ",
+	"xP8kpMarrc1oB1WZC0dH4xuGmRHo7QbRycglitmurKObxADvFlGp5rtdBNCw+/uKUaO3UGaHcayYqlJu",
+	"xY7WRCram1310suUFkOXaG1v23myjgljpr/vOkbb35DV9R3fXd90rU2JlMBqrTfOEwhvut72xmrfDd/+",
+	"GY/c7L2VxTUXMnbOnchsyS6gWXCgx/GrrUiFqEGQsItXhTNzONvrPYRxpYFiNqwEM0u/CBKsYdQ80Oa0",
+	"UHEFfaOruDe6LTqyJH07hFr+5WeDvrRvRRTjGZ5S6G/ejY+dJtXanOF6xfKpfloCOqBm2NZSXlJF3HeO",
+	"XqZJLkDVi9ymtrtwdnttCeBG4VTMzgiYHJtg/6ba9n7g7LDhVjzf7gQfcPKclugJYHkJaGbSVNCcD2hh",
+	"0ptVYnVAawusrhNGGU6O3WzTfCJuZdoxPhem64Jl4PaUqw7xw+lnFGemC3AZE+6OepqYMLjl2GzvYO/A",
+	"tBNr4HTNkuPkOf4qTdZUL3Gq+7m/V7kAXF7DB+zKVB0ykcpXzpCXbmvjZ4cHBwmGf7kGGwCm63XBLL66",
+	"/y9lQdZpdTkiURicfrj8n8osM064aU1sczO1bywhbSNXg+S0IJ9Amk2IWXTIeuWz3JJWP2uhItP/IFRj",
+	"/rg1vhP5ZmdTD++PhrKhZQnXHbbPdjZ267Z8hOUfQYlSZkCceUeUXQNz+qP6PNqhFISZjhFqTvkFLVju",
+	"ryaSc7MQt5GAjy6JjJwQF7W7Tt1+eGbAoX3l4CbUhiKWGGo2XpBrb0IfwsYL4v6QkF5jtZAUvFoYfOKM",
+	"DIRXWI6oCoaQvSeltJA252Sjl4wvTDi4R3zR5/SzuRtR7sXmJon17uRoIFttQKt46q0kEPPZoxHwo4Oj",
+	"+yPC8o68l44TPwpN3pizFik5PLw/Sn4UxIVqiYnVklNFPgLNlvS8QPp+FOQj3ns+sdfJPi8Ntc/eFBuC",
+	"Ydhb6YZKHk4CiagUxP5Xll/brguwGiLceq/w9758X0fej/qAadtf/mDCZ6k4fXXvghcRtxuvnmU+qXIx",
+	"Bk2b2PIc7PaEH9I8b0HXhD4t+U2XPGBjs3zpz/F9dvrKF14zpnBdd43lSfu0Gqpw+UuarMuY4VgGwvUY",
+	"LMd7lGnrTz6wWD/oMbpL0Q65aY6gGjNwmq0VRWFKN2sPKhM3oQuwJUA0W0Ea5uwEFT/q2iKN9J8qbVTC",
+	"BROlwkiery6BNUiUkNqYt7nLWJyzwmzArlFaGWjJyEb9IBSzcT+8WdsoVGlIxkv/Kq0d/CFq8YvxIr34",
+	"8XBF2/7AnOe1HzsYMoTxDg/6SCjYiumAgumB2y5xJhpCvsAmtcF7l7XEW7HEHiJrTL+PWLPkAa0+4BKE",
+	"A8zQkbhLD7VWgHpIoirrowW/m1wKtA4TRehw99l72dRHworxM5cbM7X4bicVx11X3H5senXbsTvTtv7n",
+	"pGl7uHFqge7xeU8dnF5tO/gvdwxltZN3x7As1/7BzskPtQ6+NZ7WmEs/oFZp/7uwi2KVX5+AtfsF1l7z",
+	"HPMKiOUE8fZKw3aJ+M8tAwp/H1RQtrlnaJ1gWTybMFRyzQqXkytBaSEhJ0KSdSkXkDvbwcbTDC+NPbEG",
+	"yUTetUzsmFZ2JjrttvGDe+2OjAfw4dzIO/XbXztxSePmrblOQ06yDNaaKMDrSc0JLkD8l5lk2pQdFA5d",
+	"Sm5EwtjDb4DqUsJLURT2lmdt9rpPqgjT2sbIU7R5rWCvQdrsNm8Cu+re1OSfGeyYN+1oPLMatX/2yE/h",
+	"FWHzHcko5wKT1HwHVqh9ylo1lgSqBK+uwZpYV9XVmenqDAxviYtptS6bk7md+R55WaW720S6KpPbJdq5",
+	"KjftelJmXBDPqipVpgYVz33CFhY9Nj9zIevUoODqGBF6CdJcN9v7J+93D3AHthyEmKjWTfabTxlcp6PN",
+	"vYc+oWkjYWu0bf1cyoTG7fLzEz5pv6kxhaTGeygTmtfZENNYvk62tKr8Hg0VUTsEmt5GcbUC5oP4W61w",
+	"HlZ7G1g7NMd+u7o85Ougo1+fXruC5KjOItmK9iZIaFOYVJ0l5QtQaFQErzTYE2TeKd3mLzgh9BDkGlk9",
+	"ahR2yW23+R45cak8pAB6Yb4bzh7Co8rsgOYRUjBfS14oqE0fTEvHhCXzmTkM3SFhs2Z8OsgeBkda2StM",
+	"kUyK9Rpyf8HXk7BH3kpb4LS+9ISUdLOZmsRWKTvYkXVcl/QCsPqF/2yQ6powm6ySGsrOgZTrQlBzoZsu",
+	"KOPVnf6K3m7g0whAYMjt3tOIZaLdMxAbzbwax2UfWN09hvhmGNY8+HZnBPTcNotQYhPe3kvcmz6F04Qu",
+	"yXunGd7PbVATLsknTAV0jetyVG/NfnklQOGEXmLG2aneBdr8Ou6y7TdTSV3ko61kG/c94vWOUlQkmE5a",
+	"NVjhbXjWfUbF7nmrwULFGQxQKV5jKbvRzGD2pn5QbNOY1xGVUVZ253eNJ2bu9dy6OzXVThG+Z0XVeYVo",
+	"XEn5T57U1K4CSK7PmrHtvV2nPg3lA/pdUuXX3O82Se/SC2xfdtOSkhw0ZYWxRJi7O8Ma9d1HEWr3UTRO",
+	"Ut/T+SXdJtQ04cpfSoBmS4Qs3PN1xEguW5QmHqYc5mFS0c6NxqzCeNU1rmkvQKpbxKqeXO77dLnvI4uu",
+	"9vkeQx7dI/Ok/zjJcz1i0H/c7Lu82d6Mhh/s3wle9kBbj/EaoUS40oOXeBW4oQ/x5i+6oZQ7AHJvCHSs",
+	"BN6Nee+nW9j9/7zFBXopuJaiIHv21rqBGoQkP5z8/R3jX8hefZPTPRgaU9juktwQOf5gctXyxs6nJzj2",
+	"93o2dKFYDVd6f11QFm9ZiUVHFfm966bwdBbc91ngCPBV6N6jDkzJgx4Rr6/WQkZOCXKiyAnxIvPGv2Mc",
+	"nBsVljgNg3CvjVRfhQ8PBxX5lsDDx56Dj1zxgXVR2o7tHTbzFx+OrnDMqjKIxS0Q1jR1gM0oBeOAAUQp",
+	"LusMH0xnYz6+bf40s3E188kFLUpoFXJvFkIwzRUYNaAhN0UfMrFameEkUWvDiEG4o4KTHhHegdomUxfh",
+	"NqgvzM8O0tlhOvvmn3w2S2dH6ezFPyO1V+4X5OiWsB9EOQyk3YLynrCOHWAdlq+2zzZ726rEVZN59uvI",
+	"HUi/VZq1A+9/s9xh0lq04OJY5lrz+FBPuS/57fPnAo7iic14M4racwkxUrDWVKQt8DgSmBbV8ywYWYoV",
+	"vlTerKvkgn32SGOKcHNcYp2l6A3Dx7E57iqVMPLO9VM+4R/sUGncFA5M5tYGHTpd9r/+it7tYNLjR1iJ",
+	"C8Rqm9uxaaJy4Z4TrStCM+33+FhGY0OU/zH9XuK7pmZ4JImO72XLd7l/5R+Mv9P0x2bPQ7cXt1jWHRoL",
+	"9WBjSHB7Hk8Cs2uBiXH5IcHKNoujg/zavBY58YBva1bj/O5/vbre/7q5nmK3GxPpf//vgaFctNPW1aW2",
+	"qpKqM8iqQqiRoa+mjDxwv2ZkaF96NTLyZruR7zgmFX01Y0QN4dybz1A8AZAPA0Ce2iyltyCefaySx2+t",
+	"/1rL29YU7kpIfwmXj7aBMbqccRO5b1LXfPNt8NdM7w16RK7v3w9UMDmr2s380WRW3/8FcCcnO/ZDQq7G",
+	"hN0WT37mX20cOxlbReN/V6BW37scY7hW/V0d6XiCtm4JbbWZOhXd+lglglaxktoddsGaxsP39s6Ufakg",
+	"tUXa2ercltFqtmg8cDCqwh90f9wVrhVO7Ana+oNCWz9Q+YWctHfnGLLVOmj2v5p/R8Ctd2yujZXVqvCf",
+	"tjKzFb5Vj9vVPxOgXA74CLwVSvQJUjQJ4foYUvSIQK72sjzASdAmYadQV6vzKWjXtFU+2OWFjuDprWFn",
+	"MzKhJxG6GxHqYfdDIjwRXkfHobXU3hgCM/9VvQmT+Gxr9TCDexZVzBuO7Oir5INZktj/78db6HnudmSz",
+	"Y+Pfyy1dP5u2nGn3OsZIpTErVOF1/wesOkY+e7lWQcVwg06fi6vmpTCXxmWTirOiVOxiWPaxmPwDQ8m/",
+	"rdJogXAMFUabHdywMtotSqNVsLiRJWWy565sJuCmj0r/xdZ10fyHPU+n/AaLpGkJ1Q6aVCmsmvdtS6Rt",
+	"NzK9uv3IbsrrKWGccZKuZjtgwq5oObxztthH2UYp2dwDV6aSchumvOdF80U849jqRjUqpvBAnKB3vXaY",
+	"69bOnfTk0V0jrOH7wWO4KrZ+isDtAk1FVvZDqP145v0bL3eKYgYP4T1hl38s7NKuAO6FMcBSS4D9r+bf",
+	"02l1BymZQ1FAjgo8bZdiGy8woe6uMKGZ72ecySR0E9nzeCBNJOcBQCgcd6fgpX9UbAyxHFqv3S2FpWYY",
+	"sPAUP4nALnATz82HBAI8K6Od61rodlE8LRMyN4qRwyVxj2augOsWjtIAGVMHTJxDJlauwNqyemw+VKAt",
+	"7TlS0qu1pe6qas7W1sX9bWdL4CPb0TbeYM2L7x7CvNjhDg8YPGhO7C/t67ATUNLGtlH9+4aIIjcsnDOp",
+	"dNq6GtrYRD6dwbxD6TzMUeDS7hr3nm3yu1Ned7of268ATzhtiX3kl7hPnw7fXR6+HeYOb9NIKuiwn2z3",
+	"yoMkcf7m98qU5NA/uDnqU0S7O2OXBSmnbcxWycmaOSccq3VbIt9zcqoVplzvJI+1FhSFje2+KmWRHCdL",
+	"rdfH+/uFyGixFEofvzh4cZBc/3L9/wMAs2Y+LazAAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
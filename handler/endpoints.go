@@ -1,9 +1,17 @@
 package handler
 
 import (
+	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+	"mime"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/SawitProRecruitment/UserService/generated"
 	"github.com/SawitProRecruitment/UserService/helper"
@@ -29,7 +37,57 @@ func (s *Server) PostEstate(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
 	}
 
-	id, err := s.Repository.CreateEstate(ctx.Request().Context(), repository.Estate{Length: req.Length, Width: req.Width})
+	estate := repository.Estate{
+		Length:       req.Length,
+		Width:        req.Width,
+		PlotSize:     helper.PlotSize,
+		DroneProfile: helper.DefaultDroneProfile,
+	}
+	if req.PlotSize != nil {
+		if *req.PlotSize <= 0 {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+		}
+		estate.PlotSize = *req.PlotSize
+	}
+
+	if req.Origin != nil {
+		if !isValidLocation(*req.Origin) {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+		}
+		estate.OriginLatitude = &req.Origin.Latitude
+		estate.OriginLongitude = &req.Origin.Longitude
+	}
+
+	if req.Rotation != nil {
+		if *req.Rotation < 0 || *req.Rotation > 360 {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+		}
+		estate.Rotation = *req.Rotation
+	}
+
+	if req.DroneProfile != nil {
+		profile := req.DroneProfile
+		if !overrideDroneProfile(&estate.DroneProfile, profile.Clearance, profile.TakeoffAltitude, profile.LandingAltitude, profile.CruiseFloor) {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+		}
+	}
+
+	if req.Home != nil {
+		if !isValidHome(*req.Home) {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+		}
+		estate.HomeX = &req.Home.X
+		estate.HomeY = &req.Home.Y
+	}
+
+	if req.Boundary != nil {
+		estate.Boundary = boundaryFromRequest(*req.Boundary)
+		if estate.Boundary != nil && !helper.ValidBoundary(estate, estate.Boundary) {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+		}
+	}
+
+	id, err := s.Repository.CreateEstate(ctx.Request().Context(), estate)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, nil)
 	}
@@ -37,9 +95,114 @@ func (s *Server) PostEstate(ctx echo.Context) error {
 	return ctx.JSON(http.StatusCreated, generated.CreateResponse{Id: id})
 }
 
-// Get Estate Drone Plan
-// (GET /estate/{id}/drone-plan)
-func (s *Server) GetEstateIdDronePlan(ctx echo.Context, id string, params generated.GetEstateIdDronePlanParams) error {
+// List Estates
+// (GET /estate)
+func (s *Server) GetEstate(ctx echo.Context, params generated.GetEstateParams) error {
+	filter := repository.EstateFilter{
+		SortBy:   repository.SortByCreatedAt,
+		MinArea:  params.MinArea,
+		MaxArea:  params.MaxArea,
+		MinTrees: params.MinTrees,
+		MaxTrees: params.MaxTrees,
+	}
+	if !isValidRange(params.MinArea, params.MaxArea, 1) || !isValidRange(params.MinTrees, params.MaxTrees, 0) {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+	}
+
+	limit := defaultEstatePageSize
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxEstatePageSize {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+		}
+		limit = *params.Limit
+	}
+
+	if params.Sort != nil {
+		switch *params.Sort {
+		case generated.GetEstateParamsSortCreatedAt:
+		case generated.GetEstateParamsSortArea:
+			filter.SortBy = repository.SortByArea
+		default:
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+		}
+	}
+
+	if params.Order != nil {
+		switch *params.Order {
+		case generated.Asc:
+		case generated.Desc:
+			filter.Descending = true
+		default:
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+		}
+	}
+
+	if params.Cursor != nil {
+		after, ok := decodeEstateCursor(*params.Cursor, filter)
+		if !ok {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+		}
+		filter.After = &after
+	}
+
+	// one more estate than the page tells whether there is a next one
+	filter.Limit = limit + 1
+	estates, err := s.Repository.ListEstates(ctx.Request().Context(), filter)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	resp := generated.ListEstatesResponse{Estates: make([]generated.EstateSummary, 0, min(len(estates), limit))}
+	if len(estates) > limit {
+		estates = estates[:limit]
+		next := encodeEstateCursor(estates[limit-1], filter)
+		resp.NextCursor = &next
+	}
+	for _, estate := range estates {
+		resp.Estates = append(resp.Estates, estateSummaryResponse(estate))
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Get Estate
+// (GET /estate/{id})
+func (s *Server) GetEstateId(ctx echo.Context, id string, params generated.GetEstateIdParams) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+	if acceptsGeoJSON(ctx.Request()) {
+		return s.estateGeoJSON(ctx, id, params)
+	}
+
+	estate, err := s.Repository.GetEstateSummary(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, estateSummaryResponse(estate))
+}
+
+// Update Estate
+// (PATCH /estate/{id})
+func (s *Server) PatchEstateId(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+
+	var req generated.UpdateEstateRequest
+	// Bind request body to struct
+	if err := ctx.Bind(&req); err != nil || req.Length == nil && req.Width == nil && req.DroneProfile == nil ||
+		req.Length != nil && *req.Length <= 0 || req.Width != nil && *req.Width <= 0 {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
 
 	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
 	if err != nil {
@@ -51,57 +214,88 @@ func (s *Server) GetEstateIdDronePlan(ctx echo.Context, id string, params genera
 		}
 	}
 
-	trees, err := s.Repository.GetEstateTrees(ctx.Request().Context(), id)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	if req.Length != nil {
+		estate.Length = *req.Length
+	}
+	if req.Width != nil {
+		estate.Width = *req.Width
+	}
+	if req.DroneProfile != nil {
+		profile := req.DroneProfile
+		if !overrideDroneProfile(&estate.DroneProfile, profile.Clearance, profile.TakeoffAltitude, profile.LandingAltitude, profile.CruiseFloor) {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+		}
+	}
+	if estate.Boundary != nil && !helper.ValidBoundary(estate, estate.Boundary) {
+		return ctx.JSON(http.StatusConflict, generated.ResizeConflictResponse{Message: "Boundary is outside of the new size", Trees: []generated.Tree{}})
 	}
 
-	maxDistance := 0
-	countFirstRest := false
-	var resp generated.GetEstateDronePlanResponse
-	if params.MaxDistance != nil && *params.MaxDistance > 0 {
-		maxDistance = *params.MaxDistance
-		countFirstRest = true
-		x, y := 0, 0
-		resp = generated.GetEstateDronePlanResponse{
-			Distance: 0,
-			Rest: &struct {
-				X *int `json:"x,omitempty"`
-				Y *int `json:"y,omitempty"`
-			}{X: &x, Y: &y},
+	// the elevation grid is only cleared when forced
+	archive := req.Force != nil && *req.Force
+	outside, cleared, err := s.Repository.UpdateEstate(ctx.Request().Context(), estate, archive)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
 		}
 	}
+	if cleared && !archive {
+		return ctx.JSON(http.StatusConflict, generated.ResizeConflictResponse{Message: "Elevation grid does not cover the new size", Trees: []generated.Tree{}})
+	}
 
-	if len(trees) == 0 {
-		return ctx.JSON(http.StatusOK, resp)
+	trees := make([]generated.Tree, 0, len(outside))
+	for _, tree := range outside {
+		trees = append(trees, treeResponse(tree))
+	}
+	if !archive && len(trees) > 0 {
+		return ctx.JSON(http.StatusConflict, generated.ResizeConflictResponse{Message: "Trees are outside of the new size", Trees: trees})
 	}
 
-	statsHelper := helper.Stats{
-		Estate:         estate,
-		Trees:          trees,
-		CountFirstRest: countFirstRest,
-		MaxDistance:    maxDistance,
+	summary, err := s.Repository.GetEstateSummary(ctx.Request().Context(), estate.ID)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
 	}
 
-	statsHelper.CalculateTotalDistance()
-	resp.Distance = statsHelper.Distance
-	if countFirstRest {
-		resp.Rest.X = &statsHelper.Rest.X
-		resp.Rest.Y = &statsHelper.Rest.Y
+	return ctx.JSON(http.StatusOK, generated.UpdateEstateResponse{Estate: estateSummaryResponse(summary), ArchivedTrees: trees, ElevationCleared: cleared})
+}
+
+// Delete Estate
+// (DELETE /estate/{id})
+func (s *Server) DeleteEstateId(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
 	}
 
-	return ctx.JSON(http.StatusOK, resp)
+	err = s.Repository.DeleteEstate(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
 }
 
-// Get Estate Stats
-// (GET /estate/{id}/stats)
-func (s *Server) GetEstateIdStats(ctx echo.Context, id string) error {
+// Restore Estate
+// (POST /estate/{id}/restore)
+func (s *Server) PostEstateIdRestore(ctx echo.Context, id string) error {
 	err := uuid.Validate(id)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
 	}
 
-	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	err = s.Repository.RestoreEstate(ctx.Request().Context(), id)
 	if err != nil {
 		switch err {
 		case sql.ErrNoRows:
@@ -111,30 +305,30 @@ func (s *Server) GetEstateIdStats(ctx echo.Context, id string) error {
 		}
 	}
 
-	stats, err := s.Repository.GetEstateStats(ctx.Request().Context(), estate.ID)
+	estate, err := s.Repository.GetEstateSummary(ctx.Request().Context(), id)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
 	}
 
-	return ctx.JSON(http.StatusOK, generated.GetEstateStatsResponse{
-		Count:  stats.TotalTrees,
-		Max:    stats.MaxHeight,
-		Min:    stats.MinHeight,
-		Median: stats.Median,
-	})
+	return ctx.JSON(http.StatusOK, estateSummaryResponse(estate))
 }
 
-// Create Tree Within Estate
-// (POST /estate/{id}/tree)
-func (s *Server) PostEstateIdTree(ctx echo.Context, id string) error {
+// Update Estate Boundary
+// (PUT /estate/{id}/boundary)
+func (s *Server) PutEstateIdBoundary(ctx echo.Context, id string) error {
 	err := uuid.Validate(id)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
 	}
 
-	var req generated.CreateTreeRequest
+	var req generated.UpdateBoundaryRequest
 	// Bind request body to struct
-	if err := ctx.Bind(&req); err != nil || req.X <= 0 || req.Y <= 0 || req.Height <= 0 || req.Height > 30 {
+	if err := ctx.Bind(&req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
 	}
 
@@ -148,22 +342,1727 @@ func (s *Server) PostEstateIdTree(ctx echo.Context, id string) error {
 		}
 	}
 
-	if req.X > estate.Length || req.Y > estate.Width {
+	estate.Boundary = boundaryFromRequest(req.Boundary)
+	if estate.Boundary != nil && !helper.ValidBoundary(estate, estate.Boundary) {
 		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
 	}
 
-	treeID, err := s.Repository.CreateTree(ctx.Request().Context(), repository.Tree{
-		EstateID: estate.ID,
-		X:        req.X,
-		Y:        req.Y,
-		Height:   req.Height,
+	err = s.Repository.UpdateEstateBoundary(ctx.Request().Context(), estate.ID, estate.Boundary)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	resp := generated.BoundaryResponse{
+		Boundary: make([]generated.Vertex, 0, len(estate.Boundary)),
+		Plots:    helper.CountPlots(estate),
+	}
+	for _, vertex := range estate.Boundary {
+		resp.Boundary = append(resp.Boundary, generated.Vertex{X: vertex.X, Y: vertex.Y})
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Upload Estate Elevation Grid
+// (PUT /estate/{id}/elevation)
+func (s *Server) PutEstateIdElevation(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+
+	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	elevation, err := helper.ParseElevation(estate, ctx.Request().Body)
+	if err != nil {
+		if errors.Is(err, helper.ErrElevationTooLarge) {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Estate is too large for an elevation grid"})
+		}
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	err = s.Repository.UpdateEstateElevation(ctx.Request().Context(), estate.ID, elevation)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	lowest, highest := helper.ElevationRange(elevation)
+	return ctx.JSON(http.StatusOK, generated.ElevationResponse{Lowest: lowest, Highest: highest})
+}
+
+// Get Plot Location
+// (GET /estate/{id}/plot/{x}/{y})
+func (s *Server) GetEstateIdPlotXY(ctx echo.Context, id string, x int, y int) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+
+	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	if !helper.InsideEstate(estate, x, y) {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+	}
+
+	center, ok := helper.PlotLocation(estate, x, y)
+	if !ok {
+		return ctx.JSON(http.StatusUnprocessableEntity, generated.ErrorResponse{Message: "Estate is not geo-referenced"})
+	}
+
+	corners, _ := helper.PlotCorners(estate, x, y)
+	resp := generated.GetPlotLocationResponse{
+		X:       x,
+		Y:       y,
+		Center:  generated.Location{Latitude: center.Latitude, Longitude: center.Longitude},
+		Corners: make([]generated.Location, 0, len(corners)),
+	}
+	for _, corner := range corners {
+		resp.Corners = append(resp.Corners, generated.Location{Latitude: corner.Latitude, Longitude: corner.Longitude})
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// estateGeoJSON returns an estate as a GeoJSON FeatureCollection with its
+// trees and the drone path planned with the query parameters.
+func (s *Server) estateGeoJSON(ctx echo.Context, id string, params generated.GetEstateIdParams) error {
+	statsHelper, status, err := s.loadDronePlan(ctx.Request().Context(), id, dronePlanOptions{
+		MaxDistance:     params.MaxDistance,
+		DroneID:         params.DroneId,
+		Strategy:        params.Strategy,
+		Clearance:       params.Clearance,
+		TakeoffAltitude: params.TakeoffAltitude,
+		LandingAltitude: params.LandingAltitude,
+		CruiseFloor:     params.CruiseFloor,
+		Profile:         params.Profile,
+		MaxDip:          params.MaxDip,
+		RecordWaypoints: true,
 	})
 	if err != nil {
-		if err.Error() == `pq: duplicate key value violates unique constraint "trees_estate_id_x_y_idx"` {
-			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Tree already exist"})
+		return ctx.JSON(status, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	// the estate and its trees are exported even when the drone path cannot
+	// be planned, the reason is given on the estate feature instead
+	var planErr error
+	if err := calculateDronePlan(ctx.Request().Context(), &statsHelper, params.Strategy); err != nil {
+		status, err := dronePlanError(err)
+		if status == http.StatusInternalServerError {
+			return ctx.JSON(status, generated.ErrorResponse{Message: err.Error()})
 		}
+		statsHelper.Waypoints = nil
+		planErr = err
+	}
+
+	collection := statsHelper.GeoJSON()
+	if planErr != nil {
+		collection.Features[0].Properties["drone_path_error"] = planErr.Error()
+	}
+
+	body, err := json.Marshal(collection)
+	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
 	}
 
-	return ctx.JSON(201, generated.CreateResponse{Id: treeID})
+	return ctx.Blob(http.StatusOK, mimeGeoJSON, body)
+}
+
+// Get Estate Drone Plan
+// (GET /estate/{id}/drone-plan)
+func (s *Server) GetEstateIdDronePlan(ctx echo.Context, id string, params generated.GetEstateIdDronePlanParams) error {
+	if params.Include != nil && *params.Include != generated.GetEstateIdDronePlanParamsIncludeWaypoints {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+	}
+
+	if params.Drones != nil && (*params.Drones < 1 || *params.Drones > helper.MaxFleetSize) {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+	}
+
+	opts := dronePlanOptions{
+		MaxDistance:     params.MaxDistance,
+		DroneID:         params.DroneId,
+		Strategy:        params.Strategy,
+		Clearance:       params.Clearance,
+		TakeoffAltitude: params.TakeoffAltitude,
+		LandingAltitude: params.LandingAltitude,
+		CruiseFloor:     params.CruiseFloor,
+		Profile:         params.Profile,
+		MaxDip:          params.MaxDip,
+		RecordWaypoints: params.Include != nil,
+	}
+
+	resp, status, err := s.dronePlan(ctx.Request().Context(), id, opts, params.Drones)
+	if err != nil {
+		return ctx.JSON(status, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Export Estate Drone Plan As A Mission File
+// (GET /estate/{id}/drone-plan/mission)
+func (s *Server) GetEstateIdDronePlanMission(ctx echo.Context, id string, params generated.GetEstateIdDronePlanMissionParams) error {
+	if params.Format != generated.GetEstateIdDronePlanMissionParamsFormatPlan && params.Format != generated.GetEstateIdDronePlanMissionParamsFormatWaypoints {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+	}
+
+	statsHelper, status, err := s.loadDronePlan(ctx.Request().Context(), id, dronePlanOptions{
+		MaxDistance:     params.MaxDistance,
+		DroneID:         params.DroneId,
+		Strategy:        params.Strategy,
+		Clearance:       params.Clearance,
+		TakeoffAltitude: params.TakeoffAltitude,
+		LandingAltitude: params.LandingAltitude,
+		CruiseFloor:     params.CruiseFloor,
+		Profile:         params.Profile,
+		MaxDip:          params.MaxDip,
+		RecordWaypoints: true,
+	})
+	if err != nil {
+		return ctx.JSON(status, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	// the autopilots only fly missions in latitude and longitude
+	if !helper.IsGeoReferenced(statsHelper.Estate) {
+		return ctx.JSON(http.StatusUnprocessableEntity, generated.ErrorResponse{Message: "Estate has no origin"})
+	}
+
+	if err := calculateDronePlan(ctx.Request().Context(), &statsHelper, params.Strategy); err != nil {
+		status, err := dronePlanError(err)
+		return ctx.JSON(status, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	mission, err := statsHelper.Mission()
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.%s"`, id, params.Format))
+	if params.Format == generated.GetEstateIdDronePlanMissionParamsFormatWaypoints {
+		return ctx.Blob(http.StatusOK, echo.MIMETextPlainCharsetUTF8, helper.MAVLinkWaypoints(mission))
+	}
+
+	plan, err := helper.QGCPlan(mission)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	return ctx.Blob(http.StatusOK, echo.MIMEApplicationJSON, plan)
+}
+
+// Simulate A Drone Plan
+// (POST /drone-plan/simulate)
+func (s *Server) PostDronePlanSimulate(ctx echo.Context) error {
+	var req generated.SimulateDronePlanRequest
+	// Bind request body to struct
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	if req.Drones != nil && (*req.Drones < 1 || *req.Drones > helper.MaxFleetSize) {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	opts := dronePlanOptions{
+		MaxDistance:     req.MaxDistance,
+		DroneID:         req.DroneId,
+		Strategy:        req.Strategy,
+		Profile:         req.Profile,
+		MaxDip:          req.MaxDip,
+		RecordWaypoints: req.Waypoints != nil && *req.Waypoints,
+		Trees:           make([]repository.Tree, 0, len(req.Trees)),
+	}
+	if req.DroneProfile != nil {
+		opts.Clearance = req.DroneProfile.Clearance
+		opts.TakeoffAltitude = req.DroneProfile.TakeoffAltitude
+		opts.LandingAltitude = req.DroneProfile.LandingAltitude
+		opts.CruiseFloor = req.DroneProfile.CruiseFloor
+	}
+
+	planted := make(map[helper.Rest]bool, len(req.Trees))
+	for _, tree := range req.Trees {
+		plot := helper.Rest{X: tree.X, Y: tree.Y}
+		if tree.X <= 0 || tree.Y <= 0 || tree.Height <= 0 || tree.Height > 30 || planted[plot] {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+		}
+		planted[plot] = true
+		opts.Trees = append(opts.Trees, repository.Tree{X: tree.X, Y: tree.Y, Height: tree.Height})
+	}
+
+	id := ""
+	if req.EstateId != nil {
+		if err := uuid.Validate(*req.EstateId); err != nil {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+		}
+		if req.Length != nil || req.Width != nil || req.PlotSize != nil {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+		}
+		id = *req.EstateId
+	} else {
+		if req.Length == nil || req.Width == nil || *req.Length <= 0 || *req.Width <= 0 {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+		}
+
+		estate := repository.Estate{
+			Length:       *req.Length,
+			Width:        *req.Width,
+			PlotSize:     helper.PlotSize,
+			DroneProfile: helper.DefaultDroneProfile,
+		}
+		if req.PlotSize != nil {
+			if *req.PlotSize <= 0 {
+				return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+			}
+			estate.PlotSize = *req.PlotSize
+		}
+		opts.Estate = &estate
+	}
+
+	resp, status, err := s.dronePlan(ctx.Request().Context(), id, opts, req.Drones)
+	if err != nil {
+		return ctx.JSON(status, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Get Estate Stats
+// (GET /estate/{id}/stats)
+func (s *Server) GetEstateIdStats(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+
+	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	stats, err := s.Repository.GetEstateStats(ctx.Request().Context(), estate.ID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	return ctx.JSON(http.StatusOK, generated.GetEstateStatsResponse{
+		Count:  stats.TotalTrees,
+		Max:    stats.MaxHeight,
+		Min:    stats.MinHeight,
+		Median: stats.Median,
+	})
+}
+
+// List Trees Within Estate
+// (GET /estate/{id}/tree)
+func (s *Server) GetEstateIdTree(ctx echo.Context, id string, params generated.GetEstateIdTreeParams) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+
+	filter := repository.TreeFilter{
+		SortBy:       repository.SortByPosition,
+		MinHeight:    params.MinHeight,
+		MaxHeight:    params.MaxHeight,
+		MinX:         params.X1,
+		MaxX:         params.X2,
+		MinY:         params.Y1,
+		MaxY:         params.Y2,
+		CreatedAfter: params.CreatedAfter,
+	}
+	if !isValidRange(params.MinHeight, params.MaxHeight, 1) || !isValidRange(params.X1, params.X2, 1) || !isValidRange(params.Y1, params.Y2, 1) {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+	}
+
+	limit := defaultTreePageSize
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxTreePageSize {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+		}
+		limit = *params.Limit
+	}
+
+	if params.Sort != nil {
+		switch *params.Sort {
+		case generated.GetEstateIdTreeParamsSortPosition:
+		case generated.GetEstateIdTreeParamsSortHeight:
+			filter.SortBy = repository.SortByHeight
+		case generated.GetEstateIdTreeParamsSortCreatedAt:
+			filter.SortBy = repository.SortByCreatedAt
+		default:
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+		}
+	}
+
+	if params.Order != nil {
+		switch *params.Order {
+		case generated.Asc:
+		case generated.Desc:
+			filter.Descending = true
+		default:
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+		}
+	}
+
+	if params.Cursor != nil {
+		after, ok := decodeTreeCursor(*params.Cursor, filter)
+		if !ok {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Parameters"})
+		}
+		filter.After = &after
+	}
+
+	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	// one more tree than the page tells whether there is a next one
+	filter.Limit = limit + 1
+	trees, err := s.Repository.ListEstateTrees(ctx.Request().Context(), estate.ID, filter)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	resp := generated.ListTreesResponse{Trees: make([]generated.Tree, 0, min(len(trees), limit))}
+	if len(trees) > limit {
+		trees = trees[:limit]
+		next := encodeTreeCursor(trees[limit-1], filter)
+		resp.NextCursor = &next
+	}
+	for _, tree := range trees {
+		resp.Trees = append(resp.Trees, treeResponse(tree))
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Create Tree Within Estate
+// (POST /estate/{id}/tree)
+func (s *Server) PostEstateIdTree(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+
+	var req generated.CreateTreeRequest
+	// Bind request body to struct
+	if err := ctx.Bind(&req); err != nil || req.X <= 0 || req.Y <= 0 || req.Height <= 0 || req.Height > 30 {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	if !helper.InsideEstate(estate, req.X, req.Y) {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	treeID, err := s.Repository.CreateTree(ctx.Request().Context(), repository.Tree{
+		EstateID: estate.ID,
+		X:        req.X,
+		Y:        req.Y,
+		Height:   req.Height,
+	})
+	if err != nil {
+		if isDuplicateTree(err) {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Tree already exist"})
+		}
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	return ctx.JSON(201, generated.CreateResponse{Id: treeID})
+}
+
+// Delete Tree
+// (DELETE /estate/{id}/tree/{treeId})
+func (s *Server) DeleteEstateIdTreeTreeId(ctx echo.Context, id string, treeId string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+	err = uuid.Validate(treeId)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Tree ID"})
+	}
+
+	err = s.Repository.DeleteTree(ctx.Request().Context(), id, treeId)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Tree not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Get Tree
+// (GET /estate/{id}/tree/{treeId})
+func (s *Server) GetEstateIdTreeTreeId(ctx echo.Context, id string, treeId string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+	err = uuid.Validate(treeId)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Tree ID"})
+	}
+
+	tree, err := s.Repository.GetEstateTree(ctx.Request().Context(), id, treeId)
+	if err != nil && err != sql.ErrNoRows {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+	if err == sql.ErrNoRows || tree.DeletedAt != nil {
+		return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Tree not found"})
+	}
+
+	return ctx.JSON(http.StatusOK, treeResponse(tree))
+}
+
+// Update Tree
+// (PATCH /estate/{id}/tree/{treeId})
+func (s *Server) PatchEstateIdTreeTreeId(ctx echo.Context, id string, treeId string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+	err = uuid.Validate(treeId)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Tree ID"})
+	}
+
+	var req generated.UpdateTreeRequest
+	// Bind request body to struct
+	if err := ctx.Bind(&req); err != nil || req.Height <= 0 || req.Height > 30 {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	tree, err := s.Repository.UpdateTreeHeight(ctx.Request().Context(), id, treeId, req.Height)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Tree not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, treeResponse(tree))
+}
+
+// Get Tree Height History
+// (GET /estate/{id}/tree/{treeId}/history)
+func (s *Server) GetEstateIdTreeTreeIdHistory(ctx echo.Context, id string, treeId string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+	err = uuid.Validate(treeId)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Tree ID"})
+	}
+
+	tree, err := s.Repository.GetEstateTree(ctx.Request().Context(), id, treeId)
+	if err != nil && err != sql.ErrNoRows {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+	if err == sql.ErrNoRows || tree.DeletedAt != nil {
+		return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Tree not found"})
+	}
+
+	heights, err := s.Repository.GetTreeHeights(ctx.Request().Context(), tree.ID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	resp := generated.TreeHistoryResponse{Heights: make([]generated.TreeHeight, 0, len(heights))}
+	for _, height := range heights {
+		resp.Heights = append(resp.Heights, generated.TreeHeight{Height: height.Height, MeasuredAt: height.MeasuredAt})
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Restore Tree
+// (POST /estate/{id}/tree/{treeId}/restore)
+func (s *Server) PostEstateIdTreeTreeIdRestore(ctx echo.Context, id string, treeId string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+	err = uuid.Validate(treeId)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Tree ID"})
+	}
+
+	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	tree, err := s.Repository.GetEstateTree(ctx.Request().Context(), estate.ID, treeId)
+	if err != nil && err != sql.ErrNoRows {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+	if err == sql.ErrNoRows || tree.DeletedAt == nil {
+		return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Tree not found"})
+	}
+
+	// the trees archived by a resize stay archived until the estate grows
+	// back over them
+	if !helper.InsideEstate(estate, tree.X, tree.Y) {
+		return ctx.JSON(http.StatusConflict, generated.ErrorResponse{Message: "Tree is outside of the estate"})
+	}
+
+	err = s.Repository.RestoreTree(ctx.Request().Context(), estate.ID, tree.ID)
+	if err != nil {
+		switch {
+		case err == sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Tree not found"})
+		case isDuplicateTree(err):
+			return ctx.JSON(http.StatusConflict, generated.ErrorResponse{Message: "Tree already exist"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, treeResponse(tree))
+}
+
+// Register A Landing Zone Within Estate
+// (POST /estate/{id}/landing-zone)
+func (s *Server) PostEstateIdLandingZone(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+
+	var req generated.CreateLandingZoneRequest
+	// Bind request body to struct
+	if err := ctx.Bind(&req); err != nil || req.X <= 0 || req.Y <= 0 {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	if !helper.InsideEstate(estate, req.X, req.Y) {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	zoneID, err := s.Repository.CreateLandingZone(ctx.Request().Context(), repository.LandingZone{
+		EstateID: estate.ID,
+		X:        req.X,
+		Y:        req.Y,
+	})
+	if err != nil {
+		if err.Error() == `pq: duplicate key value violates unique constraint "landing_zones_estate_id_x_y_idx"` {
+			return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Landing zone already exist"})
+		}
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, generated.CreateResponse{Id: zoneID})
+}
+
+// List Landing Zones Within Estate
+// (GET /estate/{id}/landing-zone)
+func (s *Server) GetEstateIdLandingZone(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+
+	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	zones, err := s.Repository.GetEstateLandingZones(ctx.Request().Context(), estate.ID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	resp := generated.ListLandingZonesResponse{LandingZones: make([]generated.LandingZone, 0, len(zones))}
+	for _, zone := range zones {
+		resp.LandingZones = append(resp.LandingZones, generated.LandingZone{Id: zone.ID, X: zone.X, Y: zone.Y})
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Get Landing Zone
+// (GET /estate/{id}/landing-zone/{zoneId})
+func (s *Server) GetEstateIdLandingZoneZoneId(ctx echo.Context, id string, zoneId string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+	err = uuid.Validate(zoneId)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Landing Zone ID"})
+	}
+
+	_, err = s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	zone, err := s.Repository.GetEstateLandingZone(ctx.Request().Context(), id, zoneId)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Landing zone not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, generated.LandingZone{Id: zone.ID, X: zone.X, Y: zone.Y})
+}
+
+// Delete Landing Zone
+// (DELETE /estate/{id}/landing-zone/{zoneId})
+func (s *Server) DeleteEstateIdLandingZoneZoneId(ctx echo.Context, id string, zoneId string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+	err = uuid.Validate(zoneId)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Landing Zone ID"})
+	}
+
+	_, err = s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	err = s.Repository.DeleteLandingZone(ctx.Request().Context(), id, zoneId)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Landing zone not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Mark A Restricted Area Within Estate
+// (POST /estate/{id}/restricted-area)
+func (s *Server) PostEstateIdRestrictedArea(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+
+	var req generated.CreateRestrictedAreaRequest
+	// Bind request body to struct
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+	area, ok := restrictedAreaFromRequest(req)
+	if !ok {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	if area.Length > estate.Length-area.X+1 || area.Width > estate.Width-area.Y+1 {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	area.EstateID = estate.ID
+	areaID, err := s.Repository.CreateRestrictedArea(ctx.Request().Context(), area)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, generated.CreateResponse{Id: areaID})
+}
+
+// List Restricted Areas Within Estate
+// (GET /estate/{id}/restricted-area)
+func (s *Server) GetEstateIdRestrictedArea(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+
+	estate, err := s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	areas, err := s.Repository.GetEstateRestrictedAreas(ctx.Request().Context(), estate.ID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	resp := generated.ListRestrictedAreasResponse{RestrictedAreas: make([]generated.RestrictedArea, 0, len(areas))}
+	for _, area := range areas {
+		resp.RestrictedAreas = append(resp.RestrictedAreas, restrictedAreaResponse(area))
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Get Restricted Area
+// (GET /estate/{id}/restricted-area/{areaId})
+func (s *Server) GetEstateIdRestrictedAreaAreaId(ctx echo.Context, id string, areaId string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+	err = uuid.Validate(areaId)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Restricted Area ID"})
+	}
+
+	_, err = s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	area, err := s.Repository.GetEstateRestrictedArea(ctx.Request().Context(), id, areaId)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Restricted area not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, restrictedAreaResponse(area))
+}
+
+// Delete Restricted Area
+// (DELETE /estate/{id}/restricted-area/{areaId})
+func (s *Server) DeleteEstateIdRestrictedAreaAreaId(ctx echo.Context, id string, areaId string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Estate ID"})
+	}
+	err = uuid.Validate(areaId)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Restricted Area ID"})
+	}
+
+	_, err = s.Repository.GetEstateByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Estate not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	err = s.Repository.DeleteRestrictedArea(ctx.Request().Context(), id, areaId)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Restricted area not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Register A Drone
+// (POST /drone)
+func (s *Server) PostDrone(ctx echo.Context) error {
+	var req generated.DroneRequest
+	// Bind request body to struct
+	if err := ctx.Bind(&req); err != nil || !isValidDrone(req) {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	id, err := s.Repository.CreateDrone(ctx.Request().Context(), droneFromRequest(req))
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, generated.CreateResponse{Id: id})
+}
+
+// List Drones
+// (GET /drone)
+func (s *Server) GetDrone(ctx echo.Context) error {
+	drones, err := s.Repository.GetDrones(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+	}
+
+	resp := generated.ListDronesResponse{Drones: make([]generated.Drone, 0, len(drones))}
+	for _, drone := range drones {
+		resp.Drones = append(resp.Drones, droneResponse(drone))
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// Get Drone
+// (GET /drone/{id})
+func (s *Server) GetDroneId(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Drone ID"})
+	}
+
+	drone, err := s.Repository.GetDroneByID(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Drone not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, droneResponse(drone))
+}
+
+// Update Drone
+// (PUT /drone/{id})
+func (s *Server) PutDroneId(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Drone ID"})
+	}
+
+	var req generated.DroneRequest
+	// Bind request body to struct
+	if err := ctx.Bind(&req); err != nil || !isValidDrone(req) {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Request Body"})
+	}
+
+	drone := droneFromRequest(req)
+	drone.ID = id
+	err = s.Repository.UpdateDrone(ctx.Request().Context(), drone)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Drone not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, droneResponse(drone))
+}
+
+// Delete Drone
+// (DELETE /drone/{id})
+func (s *Server) DeleteDroneId(ctx echo.Context, id string) error {
+	err := uuid.Validate(id)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, generated.ErrorResponse{Message: "Invalid Drone ID"})
+	}
+
+	err = s.Repository.DeleteDrone(ctx.Request().Context(), id)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return ctx.JSON(http.StatusNotFound, generated.ErrorResponse{Message: "Drone not found"})
+		default:
+			return ctx.JSON(http.StatusInternalServerError, generated.ErrorResponse{Message: err.Error()})
+		}
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Page sizes of the estate list.
+const (
+	defaultEstatePageSize = 20
+	maxEstatePageSize     = 100
+)
+
+// estateCursor is the next_cursor of the estate list: the position of the
+// last estate of a page, with the sort it was listed with so that it is
+// never used with another one.
+type estateCursor struct {
+	SortBy     string    `json:"sort"`
+	Descending bool      `json:"desc,omitempty"`
+	ID         string    `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	Area       int64     `json:"area"`
+}
+
+func encodeEstateCursor(estate repository.EstateSummary, filter repository.EstateFilter) string {
+	cursor, _ := json.Marshal(estateCursor{
+		SortBy:     filter.SortBy,
+		Descending: filter.Descending,
+		ID:         estate.ID,
+		CreatedAt:  estate.CreatedAt,
+		Area:       int64(estate.Length) * int64(estate.Width),
+	})
+
+	return base64.RawURLEncoding.EncodeToString(cursor)
+}
+
+func decodeEstateCursor(encoded string, filter repository.EstateFilter) (repository.EstateCursor, bool) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return repository.EstateCursor{}, false
+	}
+
+	var cursor estateCursor
+	if json.Unmarshal(data, &cursor) != nil || cursor.SortBy != filter.SortBy || cursor.Descending != filter.Descending || uuid.Validate(cursor.ID) != nil {
+		return repository.EstateCursor{}, false
+	}
+
+	return repository.EstateCursor{ID: cursor.ID, CreatedAt: cursor.CreatedAt, Area: cursor.Area}, true
+}
+
+// Page sizes of the tree list.
+const (
+	defaultTreePageSize = 100
+	maxTreePageSize     = 1000
+)
+
+// treeCursor is the next_cursor of the tree list, like estateCursor.
+type treeCursor struct {
+	SortBy     string    `json:"sort"`
+	Descending bool      `json:"desc,omitempty"`
+	ID         string    `json:"id"`
+	X          int       `json:"x"`
+	Y          int       `json:"y"`
+	Height     int       `json:"height"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func encodeTreeCursor(tree repository.Tree, filter repository.TreeFilter) string {
+	cursor, _ := json.Marshal(treeCursor{
+		SortBy:     filter.SortBy,
+		Descending: filter.Descending,
+		ID:         tree.ID,
+		X:          tree.X,
+		Y:          tree.Y,
+		Height:     tree.Height,
+		CreatedAt:  tree.CreatedAt,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(cursor)
+}
+
+func decodeTreeCursor(encoded string, filter repository.TreeFilter) (repository.TreeCursor, bool) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return repository.TreeCursor{}, false
+	}
+
+	var cursor treeCursor
+	if json.Unmarshal(data, &cursor) != nil || cursor.SortBy != filter.SortBy || cursor.Descending != filter.Descending || uuid.Validate(cursor.ID) != nil {
+		return repository.TreeCursor{}, false
+	}
+
+	return repository.TreeCursor{ID: cursor.ID, X: cursor.X, Y: cursor.Y, Height: cursor.Height, CreatedAt: cursor.CreatedAt}, true
+}
+
+// dronePlanOptions are the query parameters shared by the drone plan
+// endpoints.
+type dronePlanOptions struct {
+	MaxDistance     *int
+	DroneID         *string
+	Strategy        *generated.Strategy
+	Clearance       *int
+	TakeoffAltitude *int
+	LandingAltitude *int
+	CruiseFloor     *int
+	Profile         *generated.Profile
+	MaxDip          *int
+	RecordWaypoints bool
+
+	// Estate is planned instead of a stored one, and Trees are overlaid
+	// onto the estate, in a simulation
+	Estate *repository.Estate
+	Trees  []repository.Tree
+}
+
+// dronePlan plans the drone mission of an estate, or the missions of the
+// drones surveying it at once. When it fails, it also returns the status
+// code to respond with.
+// mimeGeoJSON is the media type of a GeoJSON document.
+const mimeGeoJSON = "application/geo+json"
+
+// acceptsGeoJSON reports whether a request asks for GeoJSON in its Accept
+// header.
+func acceptsGeoJSON(req *http.Request) bool {
+	for _, accepted := range strings.Split(req.Header.Get(echo.HeaderAccept), ",") {
+		mediaType, _, err := mime.ParseMediaType(accepted)
+		if err == nil && mediaType == mimeGeoJSON {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) dronePlan(ctx context.Context, id string, opts dronePlanOptions, drones *int) (generated.GetEstateDronePlanResponse, int, error) {
+	if drones != nil {
+		fleet, status, err := s.planFleet(ctx, id, opts, *drones)
+		if err != nil {
+			return generated.GetEstateDronePlanResponse{}, status, err
+		}
+
+		var resp generated.GetEstateDronePlanResponse
+		resp.Strategy = fleet[0].Traversal.Name()
+		sections := make([]generated.DronePlanSection, 0, len(fleet))
+		totalDistance := 0
+		for _, statsHelper := range fleet {
+			resp.Distance += statsHelper.Distance
+			totalDistance += statsHelper.TotalDistance
+
+			first, last := statsHelper.Legs[0], statsHelper.Legs[len(statsHelper.Legs)-1]
+			section := generated.DronePlanSection{
+				Start:     generated.Plot{X: first.Start.X, Y: first.Start.Y},
+				End:       generated.Plot{X: last.End.X, Y: last.End.Y},
+				Distance:  statsHelper.Distance,
+				Estimate:  flightEstimate(statsHelper.Flight, statsHelper.Performance),
+				Waypoints: dronePlanWaypoints(statsHelper),
+			}
+			if statsHelper.CountRests {
+				section.TotalDistance = &statsHelper.TotalDistance
+				section.Legs, section.Rests = dronePlanLegs(statsHelper)
+			}
+			sections = append(sections, section)
+		}
+		if fleet[0].CountRests {
+			resp.TotalDistance = &totalDistance
+		}
+		resp.Drones = &sections
+
+		return resp, http.StatusOK, nil
+	}
+
+	statsHelper, status, err := s.planDrone(ctx, id, opts)
+	if err != nil {
+		return generated.GetEstateDronePlanResponse{}, status, err
+	}
+
+	var resp generated.GetEstateDronePlanResponse
+	resp.Distance = statsHelper.Distance
+	resp.Strategy = statsHelper.Traversal.Name()
+	resp.Estimate = flightEstimate(statsHelper.Flight, statsHelper.Performance)
+	if statsHelper.CountRests {
+		resp.Rest = &struct {
+			X *int `json:"x,omitempty"`
+			Y *int `json:"y,omitempty"`
+		}{X: &statsHelper.Rest.X, Y: &statsHelper.Rest.Y}
+		resp.TotalDistance = &statsHelper.TotalDistance
+		resp.Legs, resp.Rests = dronePlanLegs(statsHelper)
+	}
+	resp.Waypoints = dronePlanWaypoints(statsHelper)
+
+	if opts.Profile != nil {
+		terrain, fixed, err := statsHelper.ProfileDistances(ctx)
+		if err != nil {
+			status, err := dronePlanError(err)
+			return generated.GetEstateDronePlanResponse{}, status, err
+		}
+
+		resp.Profile = &generated.DronePlanProfile{
+			Name:                  *opts.Profile,
+			TerrainDistance:       terrain,
+			FixedAltitudeDistance: fixed,
+			SavedDistance:         terrain - statsHelper.Distance,
+		}
+	}
+
+	return resp, http.StatusOK, nil
+}
+
+// planDrone loads an estate with its trees and plans the drone mission. When
+// it fails, it also returns the status code to respond with.
+func (s *Server) planDrone(ctx context.Context, id string, opts dronePlanOptions) (helper.Stats, int, error) {
+	statsHelper, status, err := s.loadDronePlan(ctx, id, opts)
+	if err != nil {
+		return helper.Stats{}, status, err
+	}
+
+	if err := calculateDronePlan(ctx, &statsHelper, opts.Strategy); err != nil {
+		status, err := dronePlanError(err)
+		return helper.Stats{}, status, err
+	}
+
+	return statsHelper, http.StatusOK, nil
+}
+
+// calculateDronePlan calculates a loaded drone plan, the auto strategy picks
+// the traversal of the shortest mission.
+func calculateDronePlan(ctx context.Context, statsHelper *helper.Stats, strategy *generated.Strategy) error {
+	if strategy != nil && *strategy == generated.Auto {
+		return statsHelper.CalculateBestDistance(ctx)
+	}
+
+	return statsHelper.CalculateTotalDistance(ctx)
+}
+
+// planFleet loads an estate with its trees and plans the missions of the
+// drones surveying it at once, the auto strategy picks the traversal of the
+// shortest single drone mission.
+func (s *Server) planFleet(ctx context.Context, id string, opts dronePlanOptions, drones int) ([]helper.Stats, int, error) {
+	statsHelper, status, err := s.loadDronePlan(ctx, id, opts)
+	if err != nil {
+		return nil, status, err
+	}
+
+	if opts.Strategy != nil && *opts.Strategy == generated.Auto {
+		best := statsHelper
+		best.RecordWaypoints = false
+		if err := best.CalculateBestDistance(ctx); err != nil {
+			status, err := dronePlanError(err)
+			return nil, status, err
+		}
+		statsHelper.Traversal = best.Traversal
+	}
+
+	fleet, err := statsHelper.CalculateFleetDistance(ctx, drones)
+	if err != nil {
+		if errors.Is(err, helper.ErrInvalidFleetSize) {
+			return nil, http.StatusBadRequest, errors.New("Too many drones for the estate")
+		}
+		status, err := dronePlanError(err)
+		return nil, status, err
+	}
+
+	return fleet, http.StatusOK, nil
+}
+
+// loadDronePlan validates the drone plan options and loads the estate, its
+// trees and the drone into a plan ready to be calculated.
+func (s *Server) loadDronePlan(ctx context.Context, id string, opts dronePlanOptions) (helper.Stats, int, error) {
+	var traversal helper.Traversal
+	if opts.Strategy != nil && *opts.Strategy != generated.Auto && *opts.Strategy != generated.Trees {
+		var ok bool
+		traversal, ok = helper.GetTraversal(string(*opts.Strategy))
+		if !ok {
+			return helper.Stats{}, http.StatusBadRequest, errors.New("Invalid Parameters")
+		}
+	}
+
+	var profile repository.DroneProfile
+	if !overrideDroneProfile(&profile, opts.Clearance, opts.TakeoffAltitude, opts.LandingAltitude, opts.CruiseFloor) {
+		return helper.Stats{}, http.StatusBadRequest, errors.New("Invalid Parameters")
+	}
+
+	flightProfile := helper.FlightProfiles[0]
+	if opts.Profile != nil {
+		var ok bool
+		flightProfile, ok = helper.GetFlightProfile(string(*opts.Profile))
+		if !ok {
+			return helper.Stats{}, http.StatusBadRequest, errors.New("Invalid Parameters")
+		}
+	}
+	if opts.MaxDip != nil && *opts.MaxDip < 1 {
+		return helper.Stats{}, http.StatusBadRequest, errors.New("Invalid Parameters")
+	}
+
+	if opts.DroneID != nil && uuid.Validate(*opts.DroneID) != nil {
+		return helper.Stats{}, http.StatusBadRequest, errors.New("Invalid Drone ID")
+	}
+
+	var estate repository.Estate
+	if opts.Estate != nil {
+		estate = *opts.Estate
+	} else {
+		var err error
+		estate, err = s.Repository.GetEstateByID(ctx, id)
+		if err != nil {
+			switch err {
+			case sql.ErrNoRows:
+				return helper.Stats{}, http.StatusNotFound, errors.New("Estate not found")
+			default:
+				return helper.Stats{}, http.StatusInternalServerError, err
+			}
+		}
+	}
+
+	for _, tree := range opts.Trees {
+		if !helper.InsideEstate(estate, tree.X, tree.Y) {
+			return helper.Stats{}, http.StatusBadRequest, errors.New("Invalid Request Body")
+		}
+	}
+
+	estate.DroneProfile = helper.EstateDroneProfile(estate)
+	maxDistance := opts.MaxDistance
+	var performance *helper.Performance
+	if opts.DroneID != nil {
+		drone, err := s.Repository.GetDroneByID(ctx, *opts.DroneID)
+		if err != nil {
+			switch err {
+			case sql.ErrNoRows:
+				return helper.Stats{}, http.StatusNotFound, errors.New("Drone not found")
+			default:
+				return helper.Stats{}, http.StatusInternalServerError, err
+			}
+		}
+
+		estate.DroneProfile.Clearance = drone.Clearance
+		performance = &helper.Performance{
+			Speed:            drone.Speed,
+			ClimbRate:        drone.ClimbRate,
+			DescendRate:      drone.DescendRate,
+			HorizontalEnergy: drone.HorizontalEnergy,
+			ClimbEnergy:      drone.ClimbEnergy,
+			DescendEnergy:    drone.DescendEnergy,
+		}
+		if maxDistance == nil {
+			maxDistance = &drone.Range
+		}
+	}
+	overrideDroneProfile(&estate.DroneProfile, opts.Clearance, opts.TakeoffAltitude, opts.LandingAltitude, opts.CruiseFloor)
+
+	// a simulated estate has nothing stored
+	var trees []repository.Tree
+	var areas []repository.RestrictedArea
+	var elevation repository.Elevation
+	var landingZones []helper.Rest
+	if opts.Estate == nil {
+		var err error
+		trees, err = s.Repository.GetEstateTrees(ctx, id)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return helper.Stats{}, http.StatusInternalServerError, err
+		}
+
+		areas, err = s.Repository.GetEstateRestrictedAreas(ctx, id)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return helper.Stats{}, http.StatusInternalServerError, err
+		}
+
+		elevation, err = s.Repository.GetEstateElevation(ctx, id)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return helper.Stats{}, http.StatusInternalServerError, err
+		}
+
+		// the drone rests at home when the estate has one
+		if _, ok := helper.EstateHome(estate); !ok {
+			zones, err := s.Repository.GetEstateLandingZones(ctx, id)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return helper.Stats{}, http.StatusInternalServerError, err
+			}
+			for _, zone := range zones {
+				landingZones = append(landingZones, helper.Rest{X: zone.X, Y: zone.Y})
+			}
+		}
+	}
+	trees = helper.InsideTrees(estate, overlayTrees(trees, opts.Trees))
+
+	if opts.Strategy != nil && *opts.Strategy == generated.Trees {
+		tour, err := helper.NewTreeTour(ctx, trees)
+		if err != nil {
+			return helper.Stats{}, http.StatusInternalServerError, err
+		}
+		traversal = tour
+	}
+
+	statsHelper := helper.Stats{
+		Estate:          estate,
+		Trees:           trees,
+		Traversal:       traversal,
+		RecordWaypoints: opts.RecordWaypoints,
+		Performance:     performance,
+		LandingZones:    landingZones,
+		Areas:           areas,
+		Elevation:       elevation,
+		FlightProfile:   flightProfile,
+	}
+	if opts.MaxDip != nil {
+		statsHelper.MaxDip = *opts.MaxDip
+	}
+	if maxDistance != nil && *maxDistance > 0 {
+		statsHelper.CountRests = true
+		statsHelper.MaxDistance = *maxDistance
+	}
+
+	return statsHelper, http.StatusOK, nil
+}
+
+// overlayTrees returns the trees with the hypothetical ones added, each of
+// them replacing the tree on its plot.
+func overlayTrees(trees, overlay []repository.Tree) []repository.Tree {
+	if len(overlay) == 0 {
+		return trees
+	}
+
+	replaced := make(map[helper.Rest]bool, len(overlay))
+	for _, tree := range overlay {
+		replaced[helper.Rest{X: tree.X, Y: tree.Y}] = true
+	}
+
+	overlaid := make([]repository.Tree, 0, len(trees)+len(overlay))
+	for _, tree := range trees {
+		if !replaced[helper.Rest{X: tree.X, Y: tree.Y}] {
+			overlaid = append(overlaid, tree)
+		}
+	}
+
+	return append(overlaid, overlay...)
+}
+
+// dronePlanError returns the status code and message to respond with when a
+// drone plan cannot be calculated.
+func dronePlanError(err error) (int, error) {
+	switch {
+	case errors.Is(err, helper.ErrMaxDistanceTooShort):
+		return http.StatusBadRequest, errors.New("Max distance is too short")
+	case errors.Is(err, helper.ErrNoLandingZone):
+		return http.StatusUnprocessableEntity, errors.New("No landing zone is reachable")
+	case errors.Is(err, helper.ErrNoRoute):
+		return http.StatusUnprocessableEntity, errors.New("No route around the no-fly areas")
+	case errors.Is(err, helper.ErrPlanTooLarge), errors.Is(err, helper.ErrDistanceOverflow):
+		return http.StatusBadRequest, errors.New("Drone plan is too large")
+	default:
+		return http.StatusInternalServerError, err
+	}
+}
+
+// overrideDroneProfile sets the given flight parameters of a drone profile,
+// it reports false when one of them is out of range.
+func overrideDroneProfile(profile *repository.DroneProfile, clearance, takeoffAltitude, landingAltitude, cruiseFloor *int) bool {
+	if clearance != nil {
+		if *clearance < 0 {
+			return false
+		}
+		profile.Clearance = *clearance
+	}
+
+	for _, param := range []struct {
+		value *int
+		field *int
+	}{
+		{takeoffAltitude, &profile.TakeoffAltitude},
+		{landingAltitude, &profile.LandingAltitude},
+		{cruiseFloor, &profile.CruiseFloor},
+	} {
+		if param.value != nil {
+			if *param.value <= 0 {
+				return false
+			}
+			*param.field = *param.value
+		}
+	}
+
+	return true
+}
+
+func isValidDrone(req generated.DroneRequest) bool {
+	for _, energy := range []*float64{req.HorizontalEnergy, req.ClimbEnergy, req.DescendEnergy} {
+		if energy != nil && *energy < 0 {
+			return false
+		}
+	}
+
+	return req.Model != "" && req.Range > 0 && req.Speed > 0 &&
+		req.ClimbRate > 0 && req.DescendRate > 0 && req.Clearance >= 0
+}
+
+// restrictedAreaFromRequest returns the restricted area of a request, a
+// single plot by default. It reports false when the request is invalid.
+func restrictedAreaFromRequest(req generated.CreateRestrictedAreaRequest) (repository.RestrictedArea, bool) {
+	area := repository.RestrictedArea{Kind: string(req.Kind), X: req.X, Y: req.Y, Length: 1, Width: 1}
+	if req.Length != nil {
+		area.Length = *req.Length
+	}
+	if req.Width != nil {
+		area.Width = *req.Width
+	}
+	if req.Height != nil {
+		area.Height = *req.Height
+	}
+
+	switch req.Kind {
+	case generated.NoFly:
+		if req.Height != nil {
+			return area, false
+		}
+	case generated.Obstacle:
+		if area.Height <= 0 || area.Height > 1000 {
+			return area, false
+		}
+	default:
+		return area, false
+	}
+
+	return area, area.X > 0 && area.Y > 0 && area.Length > 0 && area.Width > 0
+}
+
+// droneFromRequest returns the drone of a request, its energy costs default
+// to 0.
+func droneFromRequest(req generated.DroneRequest) repository.Drone {
+	drone := repository.Drone{
+		Model:       req.Model,
+		Range:       req.Range,
+		Speed:       req.Speed,
+		ClimbRate:   req.ClimbRate,
+		DescendRate: req.DescendRate,
+		Clearance:   req.Clearance,
+	}
+	if req.HorizontalEnergy != nil {
+		drone.HorizontalEnergy = *req.HorizontalEnergy
+	}
+	if req.ClimbEnergy != nil {
+		drone.ClimbEnergy = *req.ClimbEnergy
+	}
+	if req.DescendEnergy != nil {
+		drone.DescendEnergy = *req.DescendEnergy
+	}
+
+	return drone
+}
+
+func droneResponse(drone repository.Drone) generated.Drone {
+	return generated.Drone{
+		Id:               drone.ID,
+		Model:            drone.Model,
+		Range:            drone.Range,
+		Speed:            drone.Speed,
+		ClimbRate:        drone.ClimbRate,
+		DescendRate:      drone.DescendRate,
+		HorizontalEnergy: energyResponse(drone.HorizontalEnergy),
+		ClimbEnergy:      energyResponse(drone.ClimbEnergy),
+		DescendEnergy:    energyResponse(drone.DescendEnergy),
+		Clearance:        drone.Clearance,
+	}
+}
+
+// energyResponse returns an energy cost of a drone, nil when it is not set.
+func energyResponse(energy float64) *float64 {
+	if energy == 0 {
+		return nil
+	}
+
+	return &energy
+}
+
+func estateSummaryResponse(estate repository.EstateSummary) generated.EstateSummary {
+	return generated.EstateSummary{
+		Id:        estate.ID,
+		Length:    estate.Length,
+		Width:     estate.Width,
+		PlotSize:  estate.PlotSize,
+		Area:      int64(estate.Length) * int64(estate.Width),
+		TreeCount: estate.TreeCount,
+		CreatedAt: estate.CreatedAt,
+		UpdatedAt: estate.UpdatedAt,
+	}
+}
+
+func treeResponse(tree repository.Tree) generated.Tree {
+	return generated.Tree{
+		Id:        tree.ID,
+		X:         tree.X,
+		Y:         tree.Y,
+		Height:    tree.Height,
+		CreatedAt: tree.CreatedAt,
+		UpdatedAt: tree.UpdatedAt,
+	}
+}
+
+func restrictedAreaResponse(area repository.RestrictedArea) generated.RestrictedArea {
+	return generated.RestrictedArea{
+		Id:     area.ID,
+		Kind:   area.Kind,
+		X:      area.X,
+		Y:      area.Y,
+		Length: area.Length,
+		Width:  area.Width,
+		Height: area.Height,
+	}
+}
+
+// isDuplicateTree reports whether the error is the violation of the unique
+// plot of the trees of an estate.
+func isDuplicateTree(err error) bool {
+	return err.Error() == `pq: duplicate key value violates unique constraint "trees_estate_id_x_y_idx"`
+}
+
+// isValidRange reports whether the bounds of a filter are at least the
+// lowest value and in order.
+func isValidRange(low, high *int, lowest int) bool {
+	if low != nil && *low < lowest || high != nil && *high < lowest {
+		return false
+	}
+
+	return low == nil || high == nil || *low <= *high
+}
+
+// dronePlanLegs returns the legs of a drone plan and the plots where the
+// drone rests between them.
+func dronePlanLegs(statsHelper helper.Stats) (*[]generated.DronePlanLeg, *[]generated.Plot) {
+	legs := make([]generated.DronePlanLeg, 0, len(statsHelper.Legs))
+	rests := make([]generated.Plot, 0, len(statsHelper.Legs)-1)
+	for i, leg := range statsHelper.Legs {
+		legs = append(legs, generated.DronePlanLeg{
+			Start:    generated.Plot{X: leg.Start.X, Y: leg.Start.Y},
+			End:      generated.Plot{X: leg.End.X, Y: leg.End.Y},
+			Distance: leg.Distance,
+			Takeoff:  leg.Takeoff,
+			Landing:  leg.Landing,
+			Estimate: flightEstimate(leg.Flight, statsHelper.Performance),
+		})
+		if i < len(statsHelper.Legs)-1 {
+			rests = append(rests, generated.Plot{X: leg.End.X, Y: leg.End.Y})
+		}
+	}
+
+	return &legs, &rests
+}
+
+// dronePlanWaypoints returns the recorded waypoints of a drone plan, nil
+// when they are not recorded.
+func dronePlanWaypoints(statsHelper helper.Stats) *[]generated.DronePlanWaypoint {
+	if !statsHelper.RecordWaypoints {
+		return nil
+	}
+
+	waypoints := make([]generated.DronePlanWaypoint, 0, len(statsHelper.Waypoints))
+	for _, waypoint := range statsHelper.Waypoints {
+		waypoints = append(waypoints, generated.DronePlanWaypoint{
+			X:                  waypoint.X,
+			Y:                  waypoint.Y,
+			Altitude:           waypoint.Altitude,
+			RelativeAltitude:   waypoint.RelativeAltitude(),
+			CumulativeDistance: waypoint.Distance,
+		})
+	}
+
+	return &waypoints
+}
+
+func flightEstimate(flight helper.Flight, performance *helper.Performance) *generated.FlightEstimate {
+	if performance == nil {
+		return nil
+	}
+
+	estimate := flight.Estimate(*performance)
+	resp := &generated.FlightEstimate{DurationMinutes: estimate.Duration / 60}
+	if performance.HasEnergy() {
+		resp.EnergyWh = &estimate.Energy
+	}
+
+	return resp
+}
+
+// boundaryFromRequest returns the boundary of an estate, nil when it is a
+// full rectangle.
+func boundaryFromRequest(vertices []generated.Vertex) repository.Boundary {
+	if len(vertices) == 0 {
+		return nil
+	}
+
+	boundary := make(repository.Boundary, 0, len(vertices))
+	for _, vertex := range vertices {
+		boundary = append(boundary, repository.Vertex{X: vertex.X, Y: vertex.Y})
+	}
+
+	return boundary
+}
+
+func isValidHome(home generated.Home) bool {
+	return home.X >= math.MinInt32 && home.X <= math.MaxInt32 &&
+		home.Y >= math.MinInt32 && home.Y <= math.MaxInt32
+}
+
+func isValidLocation(location generated.Location) bool {
+	return location.Latitude >= -90 && location.Latitude <= 90 &&
+		location.Longitude >= -180 && location.Longitude <= 180
 }
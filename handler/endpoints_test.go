@@ -8,8 +8,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/SawitProRecruitment/UserService/generated"
+	"github.com/SawitProRecruitment/UserService/helper"
 	"github.com/SawitProRecruitment/UserService/repository"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -37,6 +39,131 @@ func Test_PostEstate(t *testing.T) {
 		}
 	})
 
+	t.Run("failed test case: invalid origin", func(t *testing.T) {
+		invalidBody := `{"length": 5, "width": 10, "origin": {"latitude": 91, "longitude": 10}}`
+		req := httptest.NewRequest(http.MethodPost, "/estate", bytes.NewReader([]byte(invalidBody)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		if assert.NoError(t, server.PostEstate(ctx)) {
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		}
+	})
+
+	t.Run("success test case: geo-referenced estate", func(t *testing.T) {
+		body := `{"length": 5, "width": 10, "plot_size": 20, "origin": {"latitude": -0.5, "longitude": 113.9}, "rotation": 30}`
+		req := httptest.NewRequest(http.MethodPost, "/estate", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		latitude, longitude := -0.5, 113.9
+		mockRepo.EXPECT().CreateEstate(gomock.Any(), repository.Estate{
+			Length:          5,
+			Width:           10,
+			PlotSize:        20,
+			OriginLatitude:  &latitude,
+			OriginLongitude: &longitude,
+			Rotation:        30,
+			DroneProfile:    helper.DefaultDroneProfile,
+		}).Return(uuid.New().String(), nil)
+
+		if assert.NoError(t, server.PostEstate(ctx)) {
+			assert.Equal(t, http.StatusCreated, res.Code)
+		}
+	})
+
+	t.Run("failed test case: invalid drone profile", func(t *testing.T) {
+		invalidBody := `{"length": 5, "width": 10, "drone_profile": {"takeoff_altitude": 0}}`
+		req := httptest.NewRequest(http.MethodPost, "/estate", bytes.NewReader([]byte(invalidBody)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		if assert.NoError(t, server.PostEstate(ctx)) {
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		}
+	})
+
+	t.Run("success test case: drone profile", func(t *testing.T) {
+		body := `{"length": 5, "width": 10, "drone_profile": {"clearance": 3, "cruise_floor": 5}}`
+		req := httptest.NewRequest(http.MethodPost, "/estate", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		mockRepo.EXPECT().CreateEstate(gomock.Any(), repository.Estate{
+			Length:   5,
+			Width:    10,
+			PlotSize: 10,
+			DroneProfile: repository.DroneProfile{
+				Clearance:       3,
+				TakeoffAltitude: 1,
+				LandingAltitude: 1,
+				CruiseFloor:     5,
+			},
+		}).Return(uuid.New().String(), nil)
+
+		if assert.NoError(t, server.PostEstate(ctx)) {
+			assert.Equal(t, http.StatusCreated, res.Code)
+		}
+	})
+
+	t.Run("success test case: home outside the estate", func(t *testing.T) {
+		body := `{"length": 5, "width": 10, "home": {"x": 0, "y": 3}}`
+		req := httptest.NewRequest(http.MethodPost, "/estate", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		homeX, homeY := 0, 3
+		mockRepo.EXPECT().CreateEstate(gomock.Any(), repository.Estate{
+			Length:       5,
+			Width:        10,
+			PlotSize:     10,
+			DroneProfile: helper.DefaultDroneProfile,
+			HomeX:        &homeX,
+			HomeY:        &homeY,
+		}).Return(uuid.New().String(), nil)
+
+		if assert.NoError(t, server.PostEstate(ctx)) {
+			assert.Equal(t, http.StatusCreated, res.Code)
+		}
+	})
+
+	t.Run("failed test case: boundary outside the estate", func(t *testing.T) {
+		body := `{"length": 5, "width": 10, "boundary": [{"x": 0, "y": 0}, {"x": 6, "y": 0}, {"x": 0, "y": 10}]}`
+		req := httptest.NewRequest(http.MethodPost, "/estate", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		if assert.NoError(t, server.PostEstate(ctx)) {
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		}
+	})
+
+	t.Run("success test case: boundary", func(t *testing.T) {
+		body := `{"length": 5, "width": 10, "boundary": [{"x": 0, "y": 0}, {"x": 5, "y": 0}, {"x": 0, "y": 10}]}`
+		req := httptest.NewRequest(http.MethodPost, "/estate", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		mockRepo.EXPECT().CreateEstate(gomock.Any(), repository.Estate{
+			Length:       5,
+			Width:        10,
+			PlotSize:     10,
+			DroneProfile: helper.DefaultDroneProfile,
+			Boundary:     repository.Boundary{{X: 0, Y: 0}, {X: 5, Y: 0}, {X: 0, Y: 10}},
+		}).Return(uuid.New().String(), nil)
+
+		if assert.NoError(t, server.PostEstate(ctx)) {
+			assert.Equal(t, http.StatusCreated, res.Code)
+		}
+	})
+
 	t.Run("failed test case: error create estate", func(t *testing.T) {
 		requestBody := generated.CreateEstateRequest{Length: 10, Width: 20}
 		jsonBody, _ := json.Marshal(requestBody)
@@ -45,35 +172,2382 @@ func Test_PostEstate(t *testing.T) {
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
 
-		mockRepo.EXPECT().CreateEstate(gomock.Any(), repository.Estate{Length: 10, Width: 20}).Return("", errors.New("error create estate"))
-
-		if assert.NoError(t, server.PostEstate(ctx)) {
-			assert.Equal(t, http.StatusInternalServerError, res.Code)
-		}
+		mockRepo.EXPECT().CreateEstate(gomock.Any(), repository.Estate{Length: 10, Width: 20, PlotSize: 10, DroneProfile: helper.DefaultDroneProfile}).Return("", errors.New("error create estate"))
+
+		if assert.NoError(t, server.PostEstate(ctx)) {
+			assert.Equal(t, http.StatusInternalServerError, res.Code)
+		}
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		requestBody := generated.CreateEstateRequest{Length: 10, Width: 20}
+		jsonBody, _ := json.Marshal(requestBody)
+		req := httptest.NewRequest(http.MethodPost, "/estate", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		id := uuid.New().String()
+		mockRepo.EXPECT().CreateEstate(gomock.Any(), repository.Estate{Length: 10, Width: 20, PlotSize: 10, DroneProfile: helper.DefaultDroneProfile}).Return(id, nil)
+
+		if assert.NoError(t, server.PostEstate(ctx)) {
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var responseBody map[string]string
+			json.Unmarshal(res.Body.Bytes(), &responseBody)
+			assert.Equal(t, id, responseBody["id"])
+		}
+	})
+}
+
+func Test_GetEstate(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	estates := []repository.EstateSummary{
+		{ID: uuid.NewString(), Length: 10, Width: 20, PlotSize: 10, TreeCount: 3, CreatedAt: createdAt, UpdatedAt: createdAt},
+		{ID: uuid.NewString(), Length: 5, Width: 10, PlotSize: 10, TreeCount: 0, CreatedAt: createdAt, UpdatedAt: createdAt},
+	}
+
+	t.Run("failed test case: invalid parameters", func(t *testing.T) {
+		negative, zero, one, two := -1, 0, 1, 2
+		sort := generated.GetEstateParamsSort("height")
+		garbage := "not-a-cursor"
+		for name, params := range map[string]generated.GetEstateParams{
+			"limit too low":      {Limit: &zero},
+			"empty area range":   {MinArea: &two, MaxArea: &one},
+			"area too low":       {MinArea: &zero},
+			"unknown sort":       {Sort: &sort},
+			"malformed cursor":   {Cursor: &garbage},
+			"negative min trees": {MinTrees: &negative},
+		} {
+			req := httptest.NewRequest(http.MethodGet, "/estate", nil)
+			res := httptest.NewRecorder()
+			ctx := e.NewContext(req, res)
+
+			assert.NoError(t, s.GetEstate(ctx, params))
+			assert.Equal(t, http.StatusBadRequest, res.Code, name)
+		}
+	})
+
+	t.Run("failed test case: repository error", func(t *testing.T) {
+		mockRepo.EXPECT().ListEstates(gomock.Any(), repository.EstateFilter{SortBy: repository.SortByCreatedAt, Limit: 21}).
+			Return(nil, errors.New("db error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/estate", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstate(ctx, generated.GetEstateParams{}))
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+
+	t.Run("success case: pages", func(t *testing.T) {
+		limit, maxTrees := 1, 5
+		sort, order := generated.GetEstateParamsSortArea, generated.Desc
+		params := generated.GetEstateParams{Limit: &limit, Sort: &sort, Order: &order, MaxTrees: &maxTrees}
+		filter := repository.EstateFilter{SortBy: repository.SortByArea, Descending: true, MaxTrees: &maxTrees, Limit: 2}
+
+		mockRepo.EXPECT().ListEstates(gomock.Any(), filter).Return(estates, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstate(ctx, params))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.ListEstatesResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, []generated.EstateSummary{
+			{Id: estates[0].ID, Length: 10, Width: 20, PlotSize: 10, Area: 200, TreeCount: 3, CreatedAt: createdAt, UpdatedAt: createdAt},
+		}, responseBody.Estates)
+		assert.NotNil(t, responseBody.NextCursor)
+
+		filter.After = &repository.EstateCursor{ID: estates[0].ID, CreatedAt: createdAt, Area: 200}
+		mockRepo.EXPECT().ListEstates(gomock.Any(), filter).Return(estates[1:], nil)
+
+		params.Cursor = responseBody.NextCursor
+		req = httptest.NewRequest(http.MethodGet, "/estate", nil)
+		res = httptest.NewRecorder()
+		ctx = e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstate(ctx, params))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		responseBody = generated.ListEstatesResponse{}
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Len(t, responseBody.Estates, 1)
+		assert.Equal(t, estates[1].ID, responseBody.Estates[0].Id)
+		assert.Nil(t, responseBody.NextCursor)
+
+		// the cursor of an area sorted list is not valid for another sort
+		params.Sort = nil
+		req = httptest.NewRequest(http.MethodGet, "/estate", nil)
+		res = httptest.NewRecorder()
+		ctx = e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstate(ctx, params))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func Test_GetEstateId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID := uuid.NewString()
+
+	t.Run("failed test case: invalid estate id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/estate/invalid", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateId(ctx, "invalid", generated.GetEstateIdParams{}))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateSummary(gomock.Any(), estateID).Return(repository.EstateSummary{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateId(ctx, estateID, generated.GetEstateIdParams{}))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		updatedAt := createdAt.Add(time.Hour)
+		mockRepo.EXPECT().GetEstateSummary(gomock.Any(), estateID).Return(repository.EstateSummary{
+			ID: estateID, Length: 10, Width: 20, PlotSize: 5, TreeCount: 7, CreatedAt: createdAt, UpdatedAt: updatedAt,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateId(ctx, estateID, generated.GetEstateIdParams{}))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.EstateSummary
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, generated.EstateSummary{
+			Id: estateID, Length: 10, Width: 20, PlotSize: 5, Area: 200, TreeCount: 7, CreatedAt: createdAt, UpdatedAt: updatedAt,
+		}, responseBody)
+	})
+
+	t.Run("success case: area past 32 bits", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateSummary(gomock.Any(), estateID).Return(repository.EstateSummary{
+			ID: estateID, Length: 50000, Width: 50000, PlotSize: 10,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateId(ctx, estateID, generated.GetEstateIdParams{}))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.EstateSummary
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, int64(2500000000), responseBody.Area)
+	})
+
+	t.Run("failed test case: geojson of an estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID, nil)
+		req.Header.Set(echo.HeaderAccept, "application/geo+json")
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.GetEstateId(ctx, estateID, generated.GetEstateIdParams{})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case: geojson", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID, Length: 2, Width: 2}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), estateID).Return([]repository.Tree{
+			{ID: "tree-1", EstateID: estateID, X: 2, Y: 1, Height: 5},
+		}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), estateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), estateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), estateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID, nil)
+		req.Header.Set(echo.HeaderAccept, "application/geo+json")
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.GetEstateId(ctx, estateID, generated.GetEstateIdParams{})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "application/geo+json", res.Header().Get(echo.HeaderContentType))
+
+		var responseBody struct {
+			Type     string `json:"type"`
+			Features []struct {
+				Geometry struct {
+					Type string `json:"type"`
+				} `json:"geometry"`
+			} `json:"features"`
+		}
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, "FeatureCollection", responseBody.Type)
+		assert.Len(t, responseBody.Features, 3)
+		assert.Equal(t, "Polygon", responseBody.Features[0].Geometry.Type)
+		assert.Equal(t, "Point", responseBody.Features[1].Geometry.Type)
+		assert.Equal(t, "LineString", responseBody.Features[2].Geometry.Type)
+	})
+
+	t.Run("success case: geojson without a drone path", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID, Length: 2, Width: 2}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), estateID).Return([]repository.Tree{
+			{ID: "tree-1", EstateID: estateID, X: 2, Y: 1, Height: 5},
+		}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), estateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), estateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), estateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID, nil)
+		req.Header.Set(echo.HeaderAccept, "application/geo+json")
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		maxDistance := 1
+		err := s.GetEstateId(ctx, estateID, generated.GetEstateIdParams{MaxDistance: &maxDistance})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody struct {
+			Features []struct {
+				Geometry struct {
+					Type string `json:"type"`
+				} `json:"geometry"`
+				Properties map[string]any `json:"properties"`
+			} `json:"features"`
+		}
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Len(t, responseBody.Features, 2)
+		assert.Equal(t, "Polygon", responseBody.Features[0].Geometry.Type)
+		assert.Equal(t, "Max distance is too short", responseBody.Features[0].Properties["drone_path_error"])
+		assert.Equal(t, "Point", responseBody.Features[1].Geometry.Type)
+	})
+}
+
+func Test_PatchEstateId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID := uuid.NewString()
+	estate := repository.Estate{ID: estateID, Length: 5, Width: 2, PlotSize: 10, DroneProfile: helper.DefaultDroneProfile}
+	resized := func(length, width int) repository.Estate {
+		resized := estate
+		resized.Length, resized.Width = length, width
+		return resized
+	}
+
+	patch := func(id, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPatch, "/estate/"+id, bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PatchEstateId(ctx, id))
+		return res
+	}
+
+	t.Run("failed test case: invalid estate id", func(t *testing.T) {
+		res := patch("invalid", `{"length":3}`)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: invalid request body", func(t *testing.T) {
+		for _, body := range []string{`{}`, `{"force":true}`, `{"length":0}`, `{"length":3,"width":-1}`, `not json`} {
+			res := patch(estateID, body)
+			assert.Equal(t, http.StatusBadRequest, res.Code, body)
+		}
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		res := patch(estateID, `{"length":3}`)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: invalid drone profile", func(t *testing.T) {
+		for _, body := range []string{`{"drone_profile":{"clearance":-1}}`, `{"length":3,"drone_profile":{"takeoff_altitude":0}}`} {
+			mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+
+			res := patch(estateID, body)
+			assert.Equal(t, http.StatusBadRequest, res.Code, body)
+		}
+	})
+
+	t.Run("failed test case: boundary outside of the new size", func(t *testing.T) {
+		bounded := estate
+		bounded.Boundary = repository.Boundary{{X: 0, Y: 0}, {X: 5, Y: 0}, {X: 0, Y: 2}}
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(bounded, nil)
+
+		res := patch(estateID, `{"length":3}`)
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+
+	t.Run("failed test case: trees outside of the new size", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+		mockRepo.EXPECT().UpdateEstate(gomock.Any(), resized(3, 2), false).
+			Return([]repository.Tree{{ID: "tree-1", EstateID: estateID, X: 4, Y: 1, Height: 10}}, false, nil)
+
+		res := patch(estateID, `{"length":3}`)
+		assert.Equal(t, http.StatusConflict, res.Code)
+
+		var responseBody generated.ResizeConflictResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, []generated.Tree{{Id: "tree-1", X: 4, Y: 1, Height: 10}}, responseBody.Trees)
+	})
+
+	t.Run("success case: force archives the trees outside", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+		mockRepo.EXPECT().UpdateEstate(gomock.Any(), resized(3, 1), true).
+			Return([]repository.Tree{{ID: "tree-1", EstateID: estateID, X: 4, Y: 1, Height: 10}}, false, nil)
+		mockRepo.EXPECT().GetEstateSummary(gomock.Any(), estateID).
+			Return(repository.EstateSummary{ID: estateID, Length: 3, Width: 1, PlotSize: 10, TreeCount: 2}, nil)
+
+		res := patch(estateID, `{"length":3,"width":1,"force":true}`)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.UpdateEstateResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, generated.EstateSummary{Id: estateID, Length: 3, Width: 1, PlotSize: 10, Area: 3, TreeCount: 2}, responseBody.Estate)
+		assert.Equal(t, []generated.Tree{{Id: "tree-1", X: 4, Y: 1, Height: 10}}, responseBody.ArchivedTrees)
+		assert.False(t, responseBody.ElevationCleared)
+	})
+
+	t.Run("failed test case: elevation grid does not cover the new size", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+		mockRepo.EXPECT().UpdateEstate(gomock.Any(), resized(5, 4), false).Return([]repository.Tree{}, true, nil)
+
+		res := patch(estateID, `{"width":4}`)
+		assert.Equal(t, http.StatusConflict, res.Code)
+
+		var responseBody generated.ResizeConflictResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, "Elevation grid does not cover the new size", responseBody.Message)
+		assert.Empty(t, responseBody.Trees)
+	})
+
+	t.Run("success case: force clears the elevation grid", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+		mockRepo.EXPECT().UpdateEstate(gomock.Any(), resized(5, 4), true).Return([]repository.Tree{}, true, nil)
+		mockRepo.EXPECT().GetEstateSummary(gomock.Any(), estateID).
+			Return(repository.EstateSummary{ID: estateID, Length: 5, Width: 4, PlotSize: 10}, nil)
+
+		res := patch(estateID, `{"width":4,"force":true}`)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.UpdateEstateResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.True(t, responseBody.ElevationCleared)
+		assert.Empty(t, responseBody.ArchivedTrees)
+	})
+
+	t.Run("success case: change the drone profile", func(t *testing.T) {
+		profiled := estate
+		profiled.DroneProfile.Clearance = 3
+		profiled.DroneProfile.CruiseFloor = 20
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+		mockRepo.EXPECT().UpdateEstate(gomock.Any(), profiled, false).Return([]repository.Tree{}, false, nil)
+		mockRepo.EXPECT().GetEstateSummary(gomock.Any(), estateID).
+			Return(repository.EstateSummary{ID: estateID, Length: 5, Width: 2, PlotSize: 10}, nil)
+
+		res := patch(estateID, `{"drone_profile":{"clearance":3,"cruise_floor":20}}`)
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("success case: grow the estate", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+		mockRepo.EXPECT().UpdateEstate(gomock.Any(), resized(5, 4), false).Return([]repository.Tree{}, false, nil)
+		mockRepo.EXPECT().GetEstateSummary(gomock.Any(), estateID).
+			Return(repository.EstateSummary{ID: estateID, Length: 5, Width: 4, PlotSize: 10}, nil)
+
+		res := patch(estateID, `{"width":4}`)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.UpdateEstateResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, int64(20), responseBody.Estate.Area)
+		assert.Empty(t, responseBody.ArchivedTrees)
+		assert.False(t, responseBody.ElevationCleared)
+	})
+}
+
+func Test_DeleteEstateId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID := uuid.NewString()
+
+	t.Run("failed test case: invalid estate id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/estate/invalid", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.DeleteEstateId(ctx, "invalid"))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteEstate(gomock.Any(), estateID).Return(sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodDelete, "/estate/"+estateID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.DeleteEstateId(ctx, estateID))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteEstate(gomock.Any(), estateID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/estate/"+estateID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.DeleteEstateId(ctx, estateID))
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	})
+}
+
+func Test_PostEstateIdRestore(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID := uuid.NewString()
+
+	t.Run("failed test case: deleted estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().RestoreEstate(gomock.Any(), estateID).Return(sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+estateID+"/restore", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PostEstateIdRestore(ctx, estateID))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().RestoreEstate(gomock.Any(), estateID).Return(nil)
+		mockRepo.EXPECT().GetEstateSummary(gomock.Any(), estateID).
+			Return(repository.EstateSummary{ID: estateID, Length: 5, Width: 2, PlotSize: 10, TreeCount: 3}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+estateID+"/restore", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PostEstateIdRestore(ctx, estateID))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.EstateSummary
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, generated.EstateSummary{Id: estateID, Length: 5, Width: 2, PlotSize: 10, Area: 10, TreeCount: 3}, responseBody)
+	})
+}
+
+func Test_GetEstateIdTree(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID := uuid.NewString()
+
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	trees := []repository.Tree{
+		{ID: uuid.NewString(), EstateID: estateID, X: 1, Y: 2, Height: 20, CreatedAt: createdAt, UpdatedAt: createdAt},
+		{ID: uuid.NewString(), EstateID: estateID, X: 3, Y: 1, Height: 15, CreatedAt: createdAt, UpdatedAt: createdAt},
+	}
+
+	t.Run("failed test case: invalid estate id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/estate/invalid/tree", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTree(ctx, "invalid", generated.GetEstateIdTreeParams{}))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: invalid parameters", func(t *testing.T) {
+		zero, one, two, tooMany := 0, 1, 2, 1001
+		sort := generated.GetEstateIdTreeParamsSort("area")
+		garbage := "not-a-cursor"
+		for name, params := range map[string]generated.GetEstateIdTreeParams{
+			"limit too low":      {Limit: &zero},
+			"limit too high":     {Limit: &tooMany},
+			"empty height range": {MinHeight: &two, MaxHeight: &one},
+			"height too low":     {MinHeight: &zero},
+			"empty x range":      {X1: &two, X2: &one},
+			"empty y range":      {Y1: &two, Y2: &one},
+			"y too low":          {Y2: &zero},
+			"unknown sort":       {Sort: &sort},
+			"malformed cursor":   {Cursor: &garbage},
+		} {
+			req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree", nil)
+			res := httptest.NewRecorder()
+			ctx := e.NewContext(req, res)
+
+			assert.NoError(t, s.GetEstateIdTree(ctx, estateID, params))
+			assert.Equal(t, http.StatusBadRequest, res.Code, name)
+		}
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTree(ctx, estateID, generated.GetEstateIdTreeParams{}))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: repository error", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID, Length: 5, Width: 5}, nil)
+		mockRepo.EXPECT().ListEstateTrees(gomock.Any(), estateID, repository.TreeFilter{SortBy: repository.SortByPosition, Limit: 101}).
+			Return(nil, errors.New("db error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTree(ctx, estateID, generated.GetEstateIdTreeParams{}))
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+
+	t.Run("success case: pages", func(t *testing.T) {
+		limit, x1, x2, minHeight := 1, 1, 3, 10
+		sort, order := generated.GetEstateIdTreeParamsSortHeight, generated.Desc
+		params := generated.GetEstateIdTreeParams{Limit: &limit, Sort: &sort, Order: &order, X1: &x1, X2: &x2, MinHeight: &minHeight, CreatedAfter: &createdAt}
+		filter := repository.TreeFilter{SortBy: repository.SortByHeight, Descending: true, MinHeight: &minHeight, MinX: &x1, MaxX: &x2, CreatedAfter: &createdAt, Limit: 2}
+
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID, Length: 5, Width: 5}, nil).Times(2)
+		mockRepo.EXPECT().ListEstateTrees(gomock.Any(), estateID, filter).Return(trees, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTree(ctx, estateID, params))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.ListTreesResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, []generated.Tree{
+			{Id: trees[0].ID, X: 1, Y: 2, Height: 20, CreatedAt: createdAt, UpdatedAt: createdAt},
+		}, responseBody.Trees)
+		assert.NotNil(t, responseBody.NextCursor)
+
+		filter.After = &repository.TreeCursor{ID: trees[0].ID, X: 1, Y: 2, Height: 20, CreatedAt: createdAt}
+		mockRepo.EXPECT().ListEstateTrees(gomock.Any(), estateID, filter).Return(trees[1:], nil)
+
+		params.Cursor = responseBody.NextCursor
+		req = httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree", nil)
+		res = httptest.NewRecorder()
+		ctx = e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTree(ctx, estateID, params))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		responseBody = generated.ListTreesResponse{}
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Len(t, responseBody.Trees, 1)
+		assert.Equal(t, trees[1].ID, responseBody.Trees[0].Id)
+		assert.Nil(t, responseBody.NextCursor)
+
+		// the cursor of a height sorted list is not valid for another order
+		params.Order = nil
+		req = httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree", nil)
+		res = httptest.NewRecorder()
+		ctx = e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTree(ctx, estateID, params))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func Test_PostEstateIdTree(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validEstateID := uuid.New().String()
+
+	t.Run("failed test case: invalid estate ID", func(t *testing.T) {
+		invalidID := "invalid-uuid"
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+invalidID+"/tree", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.PostEstateIdTree(ctx, invalidID)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: invalid request body", func(t *testing.T) {
+		reqBody, _ := json.Marshal(generated.CreateTreeRequest{X: -1, Y: 5, Height: 10})
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/tree", bytes.NewReader(reqBody))
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.PostEstateIdTree(ctx, validEstateID)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		reqBody, _ := json.Marshal(generated.CreateTreeRequest{X: 5, Y: 5, Height: 10})
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/tree", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.PostEstateIdTree(ctx, validEstateID)
+
+		t.Log("Response Body:", res.Body.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: outside the boundary", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
+			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10,
+				Boundary: repository.Boundary{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10}}}, nil)
+
+		reqBody, _ := json.Marshal(generated.CreateTreeRequest{X: 8, Y: 8, Height: 10})
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/tree", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.PostEstateIdTree(ctx, validEstateID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: tree already exists", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
+			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
+		mockRepo.EXPECT().CreateTree(gomock.Any(), gomock.Any()).
+			Return("", errors.New(`pq: duplicate key value violates unique constraint "trees_estate_id_x_y_idx"`))
+
+		reqBody, _ := json.Marshal(generated.CreateTreeRequest{X: 5, Y: 5, Height: 10})
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/tree", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.PostEstateIdTree(ctx, validEstateID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+
+		var responseBody map[string]string
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, "Tree already exist", responseBody["message"])
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
+			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
+		mockRepo.EXPECT().CreateTree(gomock.Any(), gomock.Any()).
+			Return("tree-id", nil)
+
+		reqBody, _ := json.Marshal(generated.CreateTreeRequest{X: 5, Y: 5, Height: 10})
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/tree", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.PostEstateIdTree(ctx, validEstateID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, res.Code)
+
+		var responseBody map[string]string
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, "tree-id", responseBody["id"])
+	})
+
+}
+
+func Test_DeleteEstateIdTreeTreeId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID, treeID := uuid.NewString(), uuid.NewString()
+
+	t.Run("failed test case: invalid tree id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, "/estate/"+estateID+"/tree/invalid", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.DeleteEstateIdTreeTreeId(ctx, estateID, "invalid"))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: tree not found", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteTree(gomock.Any(), estateID, treeID).Return(sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodDelete, "/estate/"+estateID+"/tree/"+treeID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.DeleteEstateIdTreeTreeId(ctx, estateID, treeID))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteTree(gomock.Any(), estateID, treeID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/estate/"+estateID+"/tree/"+treeID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.DeleteEstateIdTreeTreeId(ctx, estateID, treeID))
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	})
+}
+
+func Test_GetEstateIdTreeTreeId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID, treeID := uuid.NewString(), uuid.NewString()
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tree := repository.Tree{ID: treeID, EstateID: estateID, X: 2, Y: 3, Height: 12, CreatedAt: createdAt, UpdatedAt: createdAt}
+
+	t.Run("failed test case: invalid tree id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree/invalid", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTreeTreeId(ctx, estateID, "invalid"))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: tree not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).Return(repository.Tree{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree/"+treeID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTreeTreeId(ctx, estateID, treeID))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: tree deleted", func(t *testing.T) {
+		deleted := tree
+		deleted.DeletedAt = &createdAt
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).Return(deleted, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree/"+treeID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTreeTreeId(ctx, estateID, treeID))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).Return(tree, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree/"+treeID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTreeTreeId(ctx, estateID, treeID))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.Tree
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, generated.Tree{Id: treeID, X: 2, Y: 3, Height: 12, CreatedAt: createdAt, UpdatedAt: createdAt}, responseBody)
+	})
+}
+
+func Test_PatchEstateIdTreeTreeId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID, treeID := uuid.NewString(), uuid.NewString()
+
+	patch := func(treeID, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPatch, "/estate/"+estateID+"/tree/"+treeID, bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PatchEstateIdTreeTreeId(ctx, estateID, treeID))
+		return res
+	}
+
+	t.Run("failed test case: invalid tree id", func(t *testing.T) {
+		res := patch("invalid", `{"height":12}`)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: invalid request body", func(t *testing.T) {
+		for _, body := range []string{`{}`, `{"height":0}`, `{"height":31}`, `not json`} {
+			res := patch(treeID, body)
+			assert.Equal(t, http.StatusBadRequest, res.Code, body)
+		}
+	})
+
+	t.Run("failed test case: tree not found", func(t *testing.T) {
+		mockRepo.EXPECT().UpdateTreeHeight(gomock.Any(), estateID, treeID, 12).Return(repository.Tree{}, sql.ErrNoRows)
+
+		res := patch(treeID, `{"height":12}`)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		updatedAt := createdAt.AddDate(0, 6, 0)
+		mockRepo.EXPECT().UpdateTreeHeight(gomock.Any(), estateID, treeID, 12).Return(repository.Tree{
+			ID: treeID, EstateID: estateID, X: 2, Y: 3, Height: 12, CreatedAt: createdAt, UpdatedAt: updatedAt,
+		}, nil)
+
+		res := patch(treeID, `{"height":12}`)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.Tree
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, generated.Tree{Id: treeID, X: 2, Y: 3, Height: 12, CreatedAt: createdAt, UpdatedAt: updatedAt}, responseBody)
+	})
+}
+
+func Test_GetEstateIdTreeTreeIdHistory(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID, treeID := uuid.NewString(), uuid.NewString()
+	plantedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tree := repository.Tree{ID: treeID, EstateID: estateID, X: 2, Y: 3, Height: 8, CreatedAt: plantedAt, UpdatedAt: plantedAt}
+
+	get := func(treeID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/tree/"+treeID+"/history", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdTreeTreeIdHistory(ctx, estateID, treeID))
+		return res
+	}
+
+	t.Run("failed test case: invalid tree id", func(t *testing.T) {
+		res := get("invalid")
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: tree not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).Return(repository.Tree{}, sql.ErrNoRows)
+
+		res := get(treeID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: tree deleted", func(t *testing.T) {
+		deleted := tree
+		deleted.DeletedAt = &plantedAt
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).Return(deleted, nil)
+
+		res := get(treeID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: repository error", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).Return(tree, nil)
+		mockRepo.EXPECT().GetTreeHeights(gomock.Any(), treeID).Return(nil, errors.New("db error"))
+
+		res := get(treeID)
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		measuredAt := plantedAt.AddDate(0, 6, 0)
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).Return(tree, nil)
+		mockRepo.EXPECT().GetTreeHeights(gomock.Any(), treeID).Return([]repository.TreeHeight{
+			{ID: uuid.NewString(), TreeID: treeID, Height: 5, MeasuredAt: plantedAt},
+			{ID: uuid.NewString(), TreeID: treeID, Height: 8, MeasuredAt: measuredAt},
+		}, nil)
+
+		res := get(treeID)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.TreeHistoryResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, []generated.TreeHeight{
+			{Height: 5, MeasuredAt: plantedAt},
+			{Height: 8, MeasuredAt: measuredAt},
+		}, responseBody.Heights)
+	})
+}
+
+func Test_PostEstateIdTreeTreeIdRestore(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID, treeID := uuid.NewString(), uuid.NewString()
+	estate := repository.Estate{ID: estateID, Length: 5, Width: 2}
+	deletedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	restore := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+estateID+"/tree/"+treeID+"/restore", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PostEstateIdTreeTreeIdRestore(ctx, estateID, treeID))
+		return res
+	}
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		res := restore()
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: tree not deleted", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).
+			Return(repository.Tree{ID: treeID, EstateID: estateID, X: 1, Y: 1, Height: 10}, nil)
+
+		res := restore()
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: tree outside of the estate", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).
+			Return(repository.Tree{ID: treeID, EstateID: estateID, X: 6, Y: 1, Height: 10, DeletedAt: &deletedAt}, nil)
+
+		res := restore()
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+
+	t.Run("failed test case: another tree on the plot", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).
+			Return(repository.Tree{ID: treeID, EstateID: estateID, X: 1, Y: 1, Height: 10, DeletedAt: &deletedAt}, nil)
+		mockRepo.EXPECT().RestoreTree(gomock.Any(), estateID, treeID).
+			Return(errors.New(`pq: duplicate key value violates unique constraint "trees_estate_id_x_y_idx"`))
+
+		res := restore()
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(estate, nil)
+		mockRepo.EXPECT().GetEstateTree(gomock.Any(), estateID, treeID).
+			Return(repository.Tree{ID: treeID, EstateID: estateID, X: 1, Y: 1, Height: 10, DeletedAt: &deletedAt}, nil)
+		mockRepo.EXPECT().RestoreTree(gomock.Any(), estateID, treeID).Return(nil)
+
+		res := restore()
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.Tree
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, generated.Tree{Id: treeID, X: 1, Y: 1, Height: 10}, responseBody)
+	})
+}
+
+func Test_PostEstateIdLandingZone(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validEstateID := uuid.New().String()
+
+	t.Run("failed test case: invalid estate ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/estate/invalid-uuid/landing-zone", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.PostEstateIdLandingZone(ctx, "invalid-uuid")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: outside of the estate", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
+			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
+
+		reqBody, _ := json.Marshal(generated.CreateLandingZoneRequest{X: 11, Y: 5})
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/landing-zone", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.PostEstateIdLandingZone(ctx, validEstateID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: landing zone already exists", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
+			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
+		mockRepo.EXPECT().CreateLandingZone(gomock.Any(), gomock.Any()).
+			Return("", errors.New(`pq: duplicate key value violates unique constraint "landing_zones_estate_id_x_y_idx"`))
+
+		reqBody, _ := json.Marshal(generated.CreateLandingZoneRequest{X: 5, Y: 5})
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/landing-zone", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.PostEstateIdLandingZone(ctx, validEstateID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+
+		var responseBody map[string]string
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, "Landing zone already exist", responseBody["message"])
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
+			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
+		mockRepo.EXPECT().CreateLandingZone(gomock.Any(), repository.LandingZone{EstateID: validEstateID, X: 5, Y: 5}).
+			Return("zone-id", nil)
+
+		reqBody, _ := json.Marshal(generated.CreateLandingZoneRequest{X: 5, Y: 5})
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/landing-zone", bytes.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.PostEstateIdLandingZone(ctx, validEstateID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, res.Code)
+
+		var responseBody map[string]string
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, "zone-id", responseBody["id"])
+	})
+}
+
+func Test_GetEstateIdLandingZone(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID := uuid.NewString()
+
+	get := func(id string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+id+"/landing-zone", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdLandingZone(ctx, id))
+		return res
+	}
+
+	t.Run("failed test case: invalid estate id", func(t *testing.T) {
+		res := get("invalid")
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		res := get(estateID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID, Length: 10, Width: 10}, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), estateID).Return([]repository.LandingZone{
+			{ID: "zone-1", EstateID: estateID, X: 1, Y: 2},
+			{ID: "zone-2", EstateID: estateID, X: 5, Y: 5},
+		}, nil)
+
+		res := get(estateID)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.ListLandingZonesResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, []generated.LandingZone{{Id: "zone-1", X: 1, Y: 2}, {Id: "zone-2", X: 5, Y: 5}}, responseBody.LandingZones)
+	})
+}
+
+func Test_GetEstateIdLandingZoneZoneId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID, zoneID := uuid.NewString(), uuid.NewString()
+
+	get := func(zoneID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/landing-zone/"+zoneID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdLandingZoneZoneId(ctx, estateID, zoneID))
+		return res
+	}
+
+	t.Run("failed test case: invalid landing zone id", func(t *testing.T) {
+		res := get("invalid")
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate deleted", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		res := get(zoneID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: landing zone not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID}, nil)
+		mockRepo.EXPECT().GetEstateLandingZone(gomock.Any(), estateID, zoneID).Return(repository.LandingZone{}, sql.ErrNoRows)
+
+		res := get(zoneID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID}, nil)
+		mockRepo.EXPECT().GetEstateLandingZone(gomock.Any(), estateID, zoneID).
+			Return(repository.LandingZone{ID: zoneID, EstateID: estateID, X: 2, Y: 3}, nil)
+
+		res := get(zoneID)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.LandingZone
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, generated.LandingZone{Id: zoneID, X: 2, Y: 3}, responseBody)
+	})
+}
+
+func Test_DeleteEstateIdLandingZoneZoneId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID, zoneID := uuid.NewString(), uuid.NewString()
+
+	remove := func(zoneID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodDelete, "/estate/"+estateID+"/landing-zone/"+zoneID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.DeleteEstateIdLandingZoneZoneId(ctx, estateID, zoneID))
+		return res
+	}
+
+	t.Run("failed test case: invalid landing zone id", func(t *testing.T) {
+		res := remove("invalid")
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate deleted", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		res := remove(zoneID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: landing zone not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID}, nil)
+		mockRepo.EXPECT().DeleteLandingZone(gomock.Any(), estateID, zoneID).Return(sql.ErrNoRows)
+
+		res := remove(zoneID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID}, nil)
+		mockRepo.EXPECT().DeleteLandingZone(gomock.Any(), estateID, zoneID).Return(nil)
+
+		res := remove(zoneID)
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	})
+}
+
+func Test_PostEstateIdRestrictedArea(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validEstateID := uuid.New().String()
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/restricted-area", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PostEstateIdRestrictedArea(ctx, validEstateID))
+		return res
+	}
+
+	t.Run("failed test case: invalid estate ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/estate/invalid-uuid/restricted-area", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.PostEstateIdRestrictedArea(ctx, "invalid-uuid")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: obstacle without height", func(t *testing.T) {
+		res := post(`{"kind": "obstacle", "x": 1, "y": 1}`)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: no-fly area with a height", func(t *testing.T) {
+		res := post(`{"kind": "no_fly", "x": 1, "y": 1, "height": 10}`)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: unknown kind", func(t *testing.T) {
+		res := post(`{"kind": "village", "x": 1, "y": 1}`)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: outside of the estate", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
+			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
+
+		res := post(`{"kind": "no_fly", "x": 5, "y": 1, "length": 7}`)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		res := post(`{"kind": "no_fly", "x": 1, "y": 1}`)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case: no-fly row", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
+			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
+		mockRepo.EXPECT().CreateRestrictedArea(gomock.Any(), repository.RestrictedArea{
+			EstateID: validEstateID,
+			Kind:     repository.NoFlyArea,
+			X:        1,
+			Y:        10,
+			Length:   10,
+			Width:    1,
+		}).Return("area-id", nil)
+
+		res := post(`{"kind": "no_fly", "x": 1, "y": 10, "length": 10}`)
+		assert.Equal(t, http.StatusCreated, res.Code)
+
+		var responseBody map[string]string
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, "area-id", responseBody["id"])
+	})
+
+	t.Run("success case: obstacle", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
+			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
+		mockRepo.EXPECT().CreateRestrictedArea(gomock.Any(), repository.RestrictedArea{
+			EstateID: validEstateID,
+			Kind:     repository.ObstacleArea,
+			X:        4,
+			Y:        4,
+			Length:   1,
+			Width:    1,
+			Height:   45,
+		}).Return("area-id", nil)
+
+		res := post(`{"kind": "obstacle", "x": 4, "y": 4, "height": 45}`)
+		assert.Equal(t, http.StatusCreated, res.Code)
+	})
+}
+
+func Test_GetEstateIdRestrictedArea(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID := uuid.NewString()
+
+	get := func(id string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+id+"/restricted-area", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdRestrictedArea(ctx, id))
+		return res
+	}
+
+	t.Run("failed test case: invalid estate id", func(t *testing.T) {
+		res := get("invalid")
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		res := get(estateID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID, Length: 10, Width: 10}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), estateID).Return([]repository.RestrictedArea{
+			{ID: "area-1", EstateID: estateID, Kind: repository.NoFlyArea, X: 1, Y: 2, Length: 3, Width: 1},
+			{ID: "area-2", EstateID: estateID, Kind: repository.ObstacleArea, X: 4, Y: 3, Length: 1, Width: 1, Height: 45},
+		}, nil)
+
+		res := get(estateID)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.ListRestrictedAreasResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, []generated.RestrictedArea{
+			{Id: "area-1", Kind: "no_fly", X: 1, Y: 2, Length: 3, Width: 1},
+			{Id: "area-2", Kind: "obstacle", X: 4, Y: 3, Length: 1, Width: 1, Height: 45},
+		}, responseBody.RestrictedAreas)
+	})
+}
+
+func Test_GetEstateIdRestrictedAreaAreaId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID, areaID := uuid.NewString(), uuid.NewString()
+
+	get := func(areaID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+estateID+"/restricted-area/"+areaID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.GetEstateIdRestrictedAreaAreaId(ctx, estateID, areaID))
+		return res
+	}
+
+	t.Run("failed test case: invalid restricted area id", func(t *testing.T) {
+		res := get("invalid")
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate deleted", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		res := get(areaID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: restricted area not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedArea(gomock.Any(), estateID, areaID).Return(repository.RestrictedArea{}, sql.ErrNoRows)
+
+		res := get(areaID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedArea(gomock.Any(), estateID, areaID).
+			Return(repository.RestrictedArea{ID: areaID, EstateID: estateID, Kind: repository.NoFlyArea, X: 2, Y: 3, Length: 1, Width: 4}, nil)
+
+		res := get(areaID)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.RestrictedArea
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, generated.RestrictedArea{Id: areaID, Kind: "no_fly", X: 2, Y: 3, Length: 1, Width: 4}, responseBody)
+	})
+}
+
+func Test_DeleteEstateIdRestrictedAreaAreaId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+	estateID, areaID := uuid.NewString(), uuid.NewString()
+
+	remove := func(areaID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodDelete, "/estate/"+estateID+"/restricted-area/"+areaID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.DeleteEstateIdRestrictedAreaAreaId(ctx, estateID, areaID))
+		return res
+	}
+
+	t.Run("failed test case: invalid restricted area id", func(t *testing.T) {
+		res := remove("invalid")
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate deleted", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		res := remove(areaID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: restricted area not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID}, nil)
+		mockRepo.EXPECT().DeleteRestrictedArea(gomock.Any(), estateID, areaID).Return(sql.ErrNoRows)
+
+		res := remove(areaID)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), estateID).Return(repository.Estate{ID: estateID}, nil)
+		mockRepo.EXPECT().DeleteRestrictedArea(gomock.Any(), estateID, areaID).Return(nil)
+
+		res := remove(areaID)
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	})
+}
+
+func Test_GetEstateIdStats(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validEstateID := uuid.New().String()
+
+	t.Run("failed test case: invalid estate ID", func(t *testing.T) {
+		invalidID := "invalid-uuid"
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+invalidID+"/stats", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdStats(ctx, invalidID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/stats", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdStats(ctx, validEstateID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: repository error", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID}, nil)
+		mockRepo.EXPECT().GetEstateStats(gomock.Any(), validEstateID).Return(repository.Stats{}, errors.New("database error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/stats", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdStats(ctx, validEstateID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID}, nil)
+		mockRepo.EXPECT().GetEstateStats(gomock.Any(), validEstateID).Return(repository.Stats{
+			TotalTrees: 100,
+			MaxHeight:  30,
+			MinHeight:  5,
+			Median:     15,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/stats", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdStats(ctx, validEstateID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+		var resp generated.GetEstateStatsResponse
+		json.Unmarshal(res.Body.Bytes(), &resp)
+		assert.Equal(t, generated.GetEstateStatsResponse{Count: 100, Max: 30, Min: 5, Median: 15}, resp)
+	})
+}
+
+func Test_PutEstateIdBoundary(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validEstateID := uuid.New().String()
+	body := `{"boundary": [{"x": 0, "y": 0}, {"x": 10, "y": 0}, {"x": 0, "y": 10}]}`
+	boundary := repository.Boundary{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10}}
+
+	t.Run("failed test case: invalid estate ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/estate/invalid-uuid/boundary", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PutEstateIdBoundary(ctx, "invalid-uuid"))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodPut, "/estate/"+validEstateID+"/boundary", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PutEstateIdBoundary(ctx, validEstateID))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: boundary outside the estate", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 5, Width: 5}, nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/estate/"+validEstateID+"/boundary", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PutEstateIdBoundary(ctx, validEstateID))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
+		mockRepo.EXPECT().UpdateEstateBoundary(gomock.Any(), validEstateID, boundary).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/estate/"+validEstateID+"/boundary", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PutEstateIdBoundary(ctx, validEstateID))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var resp generated.BoundaryResponse
+		json.Unmarshal(res.Body.Bytes(), &resp)
+		assert.Equal(t, 45, resp.Plots)
+		assert.Len(t, resp.Boundary, 3)
+	})
+
+	t.Run("success case: full rectangle", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10, Boundary: boundary}, nil)
+		mockRepo.EXPECT().UpdateEstateBoundary(gomock.Any(), validEstateID, repository.Boundary(nil)).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/estate/"+validEstateID+"/boundary", bytes.NewReader([]byte(`{"boundary": []}`)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PutEstateIdBoundary(ctx, validEstateID))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var resp generated.BoundaryResponse
+		json.Unmarshal(res.Body.Bytes(), &resp)
+		assert.Equal(t, 100, resp.Plots)
+	})
+}
+
+func Test_GetEstateIdDronePlan(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validEstateID := uuid.New().String()
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: internal server error on GetEstateByID", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, errors.New("database error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+
+	t.Run("failed test case: internal server error on GetEstateTrees", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return(nil, errors.New("database error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 1, Width: 1}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return(nil, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan", nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("failed test case: invalid include", func(t *testing.T) {
+		include := generated.GetEstateIdDronePlanParamsInclude("legs")
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?include=legs", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Include: &include})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("success case: include waypoints", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 2, Width: 1}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{
+			{EstateID: validEstateID, X: 2, Y: 1, Height: 5},
+		}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?include=waypoints", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		include := generated.GetEstateIdDronePlanParamsIncludeWaypoints
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Include: &include})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 22, responseBody.Distance)
+		assert.Equal(t, &[]generated.DronePlanWaypoint{
+			{X: 1, Y: 1, Altitude: 0, RelativeAltitude: 0, CumulativeDistance: 0},
+			{X: 1, Y: 1, Altitude: 1, RelativeAltitude: 1, CumulativeDistance: 1},
+			{X: 1, Y: 1, Altitude: 6, RelativeAltitude: 6, CumulativeDistance: 6},
+			{X: 2, Y: 1, Altitude: 6, RelativeAltitude: 6, CumulativeDistance: 16},
+			{X: 2, Y: 1, Altitude: 0, RelativeAltitude: 0, CumulativeDistance: 22},
+		}, responseBody.Waypoints)
+	})
+
+	t.Run("success case: elevation grid", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 2, Width: 1}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{
+			{EstateID: validEstateID, X: 2, Y: 1, Height: 5},
+		}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(repository.Elevation{{100, 110}}, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?include=waypoints", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		include := generated.GetEstateIdDronePlanParamsIncludeWaypoints
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Include: &include})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 32, responseBody.Distance)
+		assert.Equal(t, &[]generated.DronePlanWaypoint{
+			{X: 1, Y: 1, Altitude: 100, RelativeAltitude: 0, CumulativeDistance: 0},
+			{X: 1, Y: 1, Altitude: 101, RelativeAltitude: 1, CumulativeDistance: 1},
+			{X: 1, Y: 1, Altitude: 116, RelativeAltitude: 16, CumulativeDistance: 16},
+			{X: 2, Y: 1, Altitude: 116, RelativeAltitude: 6, CumulativeDistance: 26},
+			{X: 2, Y: 1, Altitude: 110, RelativeAltitude: 0, CumulativeDistance: 32},
+		}, responseBody.Waypoints)
+	})
+
+	t.Run("success case: max distance split into legs", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 5, Width: 1}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?max_distance=25", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		maxDistance := 25
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{MaxDistance: &maxDistance})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 42, responseBody.Distance)
+		assert.Equal(t, 44, *responseBody.TotalDistance)
+		assert.Equal(t, 3, *responseBody.Rest.X)
+		assert.Equal(t, &[]generated.Plot{{X: 3, Y: 1}}, responseBody.Rests)
+		assert.Len(t, *responseBody.Legs, 2)
+	})
+
+	t.Run("failed test case: max distance too short", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 5, Width: 1}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?max_distance=5", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		maxDistance := 5
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{MaxDistance: &maxDistance})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("success case: auto strategy", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 3, Width: 2}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{
+			{EstateID: validEstateID, X: 2, Y: 1, Height: 10},
+			{EstateID: validEstateID, X: 2, Y: 2, Height: 10},
+		}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?strategy=auto", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		strategy := generated.Auto
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Strategy: &strategy})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 72, responseBody.Distance)
+		assert.Equal(t, "column", responseBody.Strategy)
+	})
+
+	t.Run("success case: drone profile overridden by query", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{
+			ID:     validEstateID,
+			Length: 2,
+			Width:  1,
+			DroneProfile: repository.DroneProfile{
+				Clearance:       2,
+				TakeoffAltitude: 1,
+				LandingAltitude: 1,
+				CruiseFloor:     1,
+			},
+		}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{
+			{EstateID: validEstateID, X: 2, Y: 1, Height: 5},
+		}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?clearance=3&cruise_floor=4", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		clearance, cruiseFloor := 3, 4
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Clearance: &clearance, CruiseFloor: &cruiseFloor})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 26, responseBody.Distance)
+	})
+
+	t.Run("failed test case: invalid clearance", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?clearance=-1", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		clearance := -1
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Clearance: &clearance})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("success case: drone range", func(t *testing.T) {
+		droneID := uuid.New().String()
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 5, Width: 1}, nil)
+		mockRepo.EXPECT().GetDroneByID(gomock.Any(), droneID).Return(repository.Drone{
+			ID:               droneID,
+			Range:            25,
+			Speed:            10,
+			ClimbRate:        1,
+			DescendRate:      2,
+			HorizontalEnergy: 0.1,
+			ClimbEnergy:      0.5,
+			DescendEnergy:    0.2,
+			Clearance:        1,
+		}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?drone_id="+droneID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{DroneId: &droneID})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 42, responseBody.Distance)
+		assert.Len(t, *responseBody.Legs, 2)
+		assert.InDelta(t, 7.0/60, responseBody.Estimate.DurationMinutes, 1e-9)
+		assert.InDelta(t, 5.4, *responseBody.Estimate.EnergyWh, 1e-9)
+		assert.InDelta(t, 2.7, *(*responseBody.Legs)[0].Estimate.EnergyWh, 1e-9)
+	})
+
+	t.Run("success case: drone without energy costs", func(t *testing.T) {
+		droneID := uuid.New().String()
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 5, Width: 1}, nil)
+		mockRepo.EXPECT().GetDroneByID(gomock.Any(), droneID).Return(repository.Drone{
+			ID:          droneID,
+			Range:       25,
+			Speed:       10,
+			ClimbRate:   1,
+			DescendRate: 2,
+			Clearance:   1,
+		}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?drone_id="+droneID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{DroneId: &droneID})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.InDelta(t, 7.0/60, responseBody.Estimate.DurationMinutes, 1e-9)
+		assert.Nil(t, responseBody.Estimate.EnergyWh)
+		assert.NotContains(t, res.Body.String(), "energy_wh")
+	})
+
+	t.Run("failed test case: drone not found", func(t *testing.T) {
+		droneID := uuid.New().String()
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 5, Width: 1}, nil)
+		mockRepo.EXPECT().GetDroneByID(gomock.Any(), droneID).Return(repository.Drone{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?drone_id="+droneID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{DroneId: &droneID})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case: drones", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 3, Width: 2}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?drones=2", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		drones := 2
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Drones: &drones})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 44, responseBody.Distance)
+		assert.Equal(t, &[]generated.DronePlanSection{
+			{Start: generated.Plot{X: 1, Y: 1}, End: generated.Plot{X: 3, Y: 1}, Distance: 22},
+			{Start: generated.Plot{X: 3, Y: 2}, End: generated.Plot{X: 1, Y: 2}, Distance: 22},
+		}, responseBody.Drones)
+	})
+
+	t.Run("failed test case: more drones than plots", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 1, Width: 1}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?drones=2", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		drones := 2
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Drones: &drones})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: invalid drones", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?drones=0", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		drones := 0
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Drones: &drones})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("success case: trees strategy", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 5, Width: 5}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{
+			{EstateID: validEstateID, X: 3, Y: 1, Height: 4},
+		}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?strategy=trees", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		strategy := generated.Trees
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Strategy: &strategy})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 1+20+4+5, responseBody.Distance)
+		assert.Equal(t, "trees", responseBody.Strategy)
+	})
+
+	t.Run("success case: rest at home", func(t *testing.T) {
+		homeX, homeY := 0, 1
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{
+			ID:     validEstateID,
+			Length: 3,
+			Width:  3,
+			HomeX:  &homeX,
+			HomeY:  &homeY,
+		}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?max_distance=110", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		maxDistance := 110
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{MaxDistance: &maxDistance})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Greater(t, len(*responseBody.Legs), 1)
+		for _, leg := range *responseBody.Legs {
+			assert.Equal(t, generated.Plot{X: 0, Y: 1}, leg.Start)
+			assert.Equal(t, generated.Plot{X: 0, Y: 1}, leg.End)
+		}
+	})
+
+	t.Run("failed test case: no landing zone is reachable", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{
+			ID:     validEstateID,
+			Length: 20,
+			Width:  1,
+		}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{
+			{EstateID: validEstateID, X: 1, Y: 1},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?max_distance=100", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		maxDistance := 100
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{MaxDistance: &maxDistance})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+
+		var responseBody generated.ErrorResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, "No landing zone is reachable", responseBody.Message)
+	})
+
+	t.Run("failed test case: no route around the no-fly areas", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{
+			ID:     validEstateID,
+			Length: 3,
+			Width:  3,
+		}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{
+			{EstateID: validEstateID, Kind: repository.NoFlyArea, X: 2, Y: 1, Length: 1, Width: 3},
+		}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+
+		var responseBody generated.ErrorResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, "No route around the no-fly areas", responseBody.Message)
+	})
+
+	t.Run("failed test case: invalid strategy", func(t *testing.T) {
+		strategy := generated.Strategy("zigzag")
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?strategy=zigzag", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+		ctx.SetRequest(req)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Strategy: &strategy})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: invalid profile", func(t *testing.T) {
+		profile := generated.Profile("hover")
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?profile=hover", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Profile: &profile})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: invalid max dip", func(t *testing.T) {
+		profile, maxDip := generated.Smooth, 0
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?profile=smooth&max_dip=0", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Profile: &profile, MaxDip: &maxDip})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("success case: smoothed profile", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 3, Width: 1}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{
+			{EstateID: validEstateID, X: 1, Y: 1, Height: 10},
+			{EstateID: validEstateID, X: 3, Y: 1, Height: 10},
+		}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan?profile=smooth", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		profile := generated.Smooth
+		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{Profile: &profile})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 42, responseBody.Distance)
+		assert.Equal(t, &generated.DronePlanProfile{
+			Name:                  generated.Smooth,
+			TerrainDistance:       62,
+			FixedAltitudeDistance: 42,
+			SavedDistance:         20,
+		}, responseBody.Profile)
+	})
+}
+
+func Test_PostDronePlanSimulate(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validEstateID := uuid.New().String()
+
+	for name, body := range map[string]string{
+		"estate without size":        `{"trees": []}`,
+		"tree outside the estate":    `{"length": 3, "width": 1, "trees": [{"x": 4, "y": 1, "height": 10}]}`,
+		"two trees on the same plot": `{"length": 3, "width": 1, "trees": [{"x": 2, "y": 1, "height": 10}, {"x": 2, "y": 1, "height": 5}]}`,
+		"size of an existing estate": `{"estate_id": "` + validEstateID + `", "length": 3, "trees": []}`,
+		"invalid estate ID":          `{"estate_id": "invalid-uuid", "trees": []}`,
+	} {
+		t.Run("failed test case: "+name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/drone-plan/simulate", bytes.NewReader([]byte(body)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			res := httptest.NewRecorder()
+			ctx := e.NewContext(req, res)
+
+			assert.NoError(t, s.PostDronePlanSimulate(ctx))
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	}
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		body := `{"estate_id": "` + validEstateID + `", "trees": []}`
+		req := httptest.NewRequest(http.MethodPost, "/drone-plan/simulate", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PostDronePlanSimulate(ctx))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case: hypothetical estate", func(t *testing.T) {
+		body := `{"length": 3, "width": 1, "trees": [{"x": 2, "y": 1, "height": 10}], "drone_profile": {"clearance": 2}, "waypoints": true}`
+		req := httptest.NewRequest(http.MethodPost, "/drone-plan/simulate", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PostDronePlanSimulate(ctx))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 1+1+(10+10)*2+2, responseBody.Distance)
+		assert.Equal(t, "row", responseBody.Strategy)
+		assert.Len(t, *responseBody.Waypoints, 8)
+	})
+
+	t.Run("success case: trees overlaid onto an estate", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 3, Width: 1}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{
+			{EstateID: validEstateID, X: 2, Y: 1, Height: 5},
+		}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		body := `{"estate_id": "` + validEstateID + `", "trees": [{"x": 2, "y": 1, "height": 10}, {"x": 3, "y": 1, "height": 10}]}`
+		req := httptest.NewRequest(http.MethodPost, "/drone-plan/simulate", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PostDronePlanSimulate(ctx))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		// the tree of 5 meters is replaced by one of 10 meters
+		var responseBody generated.GetEstateDronePlanResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, 1+(10+10)+10+(10+1), responseBody.Distance)
+	})
+}
+
+func Test_PutEstateIdElevation(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validEstateID := uuid.New().String()
+	estate := repository.Estate{ID: validEstateID, Length: 3, Width: 2}
+
+	t.Run("failed test case: invalid estate ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/estate/invalid-uuid/elevation", bytes.NewReader([]byte("1,2,3\n4,5,6\n")))
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PutEstateIdElevation(ctx, "invalid-uuid"))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodPut, "/estate/"+validEstateID+"/elevation", bytes.NewReader([]byte("1,2,3\n4,5,6\n")))
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PutEstateIdElevation(ctx, validEstateID))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("failed test case: grid not matching the estate", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(estate, nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/estate/"+validEstateID+"/elevation", bytes.NewReader([]byte("1,2,3\n")))
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PutEstateIdElevation(ctx, validEstateID))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
 	})
 
-	t.Run("success test case", func(t *testing.T) {
-		requestBody := generated.CreateEstateRequest{Length: 10, Width: 20}
-		jsonBody, _ := json.Marshal(requestBody)
-		req := httptest.NewRequest(http.MethodPost, "/estate", bytes.NewReader(jsonBody))
-		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(estate, nil)
+		mockRepo.EXPECT().UpdateEstateElevation(gomock.Any(), validEstateID, repository.Elevation{{1, 2, 3}, {4, 5, 6}}).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/estate/"+validEstateID+"/elevation", bytes.NewReader([]byte("1,2,3\n4,5,6\n")))
+		req.Header.Set(echo.HeaderContentType, "text/csv")
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
 
-		id := uuid.New().String()
-		mockRepo.EXPECT().CreateEstate(gomock.Any(), repository.Estate{Length: 10, Width: 20}).Return(id, nil)
-
-		if assert.NoError(t, server.PostEstate(ctx)) {
-			assert.Equal(t, http.StatusCreated, res.Code)
+		assert.NoError(t, s.PutEstateIdElevation(ctx, validEstateID))
+		assert.Equal(t, http.StatusOK, res.Code)
 
-			var responseBody map[string]string
-			json.Unmarshal(res.Body.Bytes(), &responseBody)
-			assert.Equal(t, id, responseBody["id"])
-		}
+		var resp generated.ElevationResponse
+		json.Unmarshal(res.Body.Bytes(), &resp)
+		assert.Equal(t, generated.ElevationResponse{Lowest: 1, Highest: 6}, resp)
 	})
 }
 
-func Test_PostEstateIdTree(t *testing.T) {
+func Test_GetEstateIdPlotXY(t *testing.T) {
 	e := echo.New()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -82,93 +2556,165 @@ func Test_PostEstateIdTree(t *testing.T) {
 	s := &Server{Repository: mockRepo}
 
 	validEstateID := uuid.New().String()
+	latitude, longitude := 0.0, 0.0
 
 	t.Run("failed test case: invalid estate ID", func(t *testing.T) {
-		invalidID := "invalid-uuid"
-		req := httptest.NewRequest(http.MethodPost, "/estate/"+invalidID+"/tree", nil)
+		req := httptest.NewRequest(http.MethodGet, "/estate/invalid-uuid/plot/1/1", nil)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
 
-		err := s.PostEstateIdTree(ctx, invalidID)
-		assert.Nil(t, err)
+		err := s.GetEstateIdPlotXY(ctx, "invalid-uuid", 1, 1)
+		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, res.Code)
 	})
 
-	t.Run("failed test case: invalid request body", func(t *testing.T) {
-		reqBody, _ := json.Marshal(generated.CreateTreeRequest{X: -1, Y: 5, Height: 10})
-		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/tree", bytes.NewReader(reqBody))
+	t.Run("failed test case: plot outside the estate", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 5, Width: 5}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/plot/6/1", nil)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
 
-		err := s.PostEstateIdTree(ctx, validEstateID)
-		assert.Nil(t, err)
+		err := s.GetEstateIdPlotXY(ctx, validEstateID, 6, 1)
+		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, res.Code)
 	})
 
-	t.Run("failed test case: estate not found", func(t *testing.T) {
-		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+	t.Run("failed test case: estate is not geo-referenced", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 5, Width: 5}, nil)
 
-		reqBody, _ := json.Marshal(generated.CreateTreeRequest{X: 5, Y: 5, Height: 10})
-		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/tree", bytes.NewReader(reqBody))
-		req.Header.Set("Content-Type", "application/json")
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/plot/1/1", nil)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
-		ctx.SetRequest(req)
 
-		err := s.PostEstateIdTree(ctx, validEstateID)
+		err := s.GetEstateIdPlotXY(ctx, validEstateID, 1, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	})
 
-		t.Log("Response Body:", res.Body.String())
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{
+			ID:              validEstateID,
+			Length:          5,
+			Width:           5,
+			PlotSize:        10,
+			OriginLatitude:  &latitude,
+			OriginLongitude: &longitude,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/plot/1/1", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
 
+		err := s.GetEstateIdPlotXY(ctx, validEstateID, 1, 1)
 		assert.NoError(t, err)
-		assert.Equal(t, http.StatusNotFound, res.Code)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.GetPlotLocationResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.InDelta(t, 0.000045, responseBody.Center.Latitude, 0.000001)
+		assert.InDelta(t, 0.000045, responseBody.Center.Longitude, 0.000001)
+		assert.Len(t, responseBody.Corners, 4)
 	})
+}
 
-	t.Run("failed test case: tree already exists", func(t *testing.T) {
-		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
-			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
-		mockRepo.EXPECT().CreateTree(gomock.Any(), gomock.Any()).
-			Return("", errors.New(`pq: duplicate key value violates unique constraint "trees_estate_id_x_y_idx"`))
+func Test_GetEstateIdDronePlanMission(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-		reqBody, _ := json.Marshal(generated.CreateTreeRequest{X: 5, Y: 5, Height: 10})
-		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/tree", bytes.NewReader(reqBody))
-		req.Header.Set("Content-Type", "application/json")
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validEstateID := uuid.New().String()
+	latitude, longitude := 1.0, 100.0
+
+	t.Run("failed test case: invalid format", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan/mission?format=kml", nil)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
 
-		err := s.PostEstateIdTree(ctx, validEstateID)
+		err := s.GetEstateIdDronePlanMission(ctx, validEstateID, generated.GetEstateIdDronePlanMissionParams{Format: "kml"})
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
 
-		var responseBody map[string]string
-		json.Unmarshal(res.Body.Bytes(), &responseBody)
-		assert.Equal(t, "Tree already exist", responseBody["message"])
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan/mission?format=plan", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.GetEstateIdDronePlanMission(ctx, validEstateID, generated.GetEstateIdDronePlanMissionParams{
+			Format: generated.GetEstateIdDronePlanMissionParamsFormatPlan,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, res.Code)
 	})
 
-	t.Run("success case", func(t *testing.T) {
-		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).
-			Return(repository.Estate{ID: validEstateID, Length: 10, Width: 10}, nil)
-		mockRepo.EXPECT().CreateTree(gomock.Any(), gomock.Any()).
-			Return("tree-id", nil)
+	t.Run("failed test case: estate has no origin", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 2, Width: 1}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
 
-		reqBody, _ := json.Marshal(generated.CreateTreeRequest{X: 5, Y: 5, Height: 10})
-		req := httptest.NewRequest(http.MethodPost, "/estate/"+validEstateID+"/tree", bytes.NewReader(reqBody))
-		req.Header.Set("Content-Type", "application/json")
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan/mission?format=plan", nil)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
-		ctx.SetRequest(req)
 
-		err := s.PostEstateIdTree(ctx, validEstateID)
+		err := s.GetEstateIdDronePlanMission(ctx, validEstateID, generated.GetEstateIdDronePlanMissionParams{
+			Format: generated.GetEstateIdDronePlanMissionParamsFormatPlan,
+		})
 		assert.NoError(t, err)
-		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	})
 
-		var responseBody map[string]string
-		json.Unmarshal(res.Body.Bytes(), &responseBody)
-		assert.Equal(t, "tree-id", responseBody["id"])
+	t.Run("success case: mavlink waypoints", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 2, Width: 1, OriginLatitude: &latitude, OriginLongitude: &longitude}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan/mission?format=waypoints", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.GetEstateIdDronePlanMission(ctx, validEstateID, generated.GetEstateIdDronePlanMissionParams{
+			Format: generated.GetEstateIdDronePlanMissionParamsFormatWaypoints,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, `attachment; filename="`+validEstateID+`.waypoints"`, res.Header().Get(echo.HeaderContentDisposition))
+		assert.Contains(t, res.Body.String(), "QGC WPL 110\n")
 	})
 
+	t.Run("success case: qgroundcontrol plan", func(t *testing.T) {
+		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID, Length: 2, Width: 1, OriginLatitude: &latitude, OriginLongitude: &longitude}, nil)
+		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return([]repository.Tree{}, nil)
+		mockRepo.EXPECT().GetEstateRestrictedAreas(gomock.Any(), validEstateID).Return([]repository.RestrictedArea{}, nil)
+		mockRepo.EXPECT().GetEstateElevation(gomock.Any(), validEstateID).Return(nil, nil)
+		mockRepo.EXPECT().GetEstateLandingZones(gomock.Any(), validEstateID).Return([]repository.LandingZone{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan/mission?format=plan", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		err := s.GetEstateIdDronePlanMission(ctx, validEstateID, generated.GetEstateIdDronePlanMissionParams{
+			Format: generated.GetEstateIdDronePlanMissionParamsFormatPlan,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody map[string]any
+		assert.NoError(t, json.Unmarshal(res.Body.Bytes(), &responseBody))
+		assert.Equal(t, "Plan", responseBody["fileType"])
+	})
 }
 
-func Test_GetEstateIdStats(t *testing.T) {
+func Test_PostDrone(t *testing.T) {
 	e := echo.New()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -176,68 +2722,107 @@ func Test_GetEstateIdStats(t *testing.T) {
 	mockRepo := repository.NewMockRepositoryInterface(ctrl)
 	s := &Server{Repository: mockRepo}
 
-	validEstateID := uuid.New().String()
-
-	t.Run("failed test case: invalid estate ID", func(t *testing.T) {
-		invalidID := "invalid-uuid"
-		req := httptest.NewRequest(http.MethodGet, "/estate/"+invalidID+"/stats", nil)
+	t.Run("failed test case: invalid request body", func(t *testing.T) {
+		body := `{"model": "T40", "range": 0, "speed": 10, "climb_rate": 4, "descend_rate": 3, "clearance": 2}`
+		req := httptest.NewRequest(http.MethodPost, "/drone", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
-		ctx.SetRequest(req)
 
-		err := s.GetEstateIdStats(ctx, invalidID)
-		assert.NoError(t, err)
+		assert.NoError(t, s.PostDrone(ctx))
 		assert.Equal(t, http.StatusBadRequest, res.Code)
 	})
 
-	t.Run("failed test case: estate not found", func(t *testing.T) {
-		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+	t.Run("success test case", func(t *testing.T) {
+		body := `{"model": "T40", "range": 4000, "speed": 10, "climb_rate": 4, "descend_rate": 3,
+			"horizontal_energy": 0.1, "climb_energy": 0.4, "descend_energy": 0.05, "clearance": 2}`
+		req := httptest.NewRequest(http.MethodPost, "/drone", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
 
-		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/stats", nil)
+		id := uuid.New().String()
+		mockRepo.EXPECT().CreateDrone(gomock.Any(), repository.Drone{
+			Model:            "T40",
+			Range:            4000,
+			Speed:            10,
+			ClimbRate:        4,
+			DescendRate:      3,
+			HorizontalEnergy: 0.1,
+			ClimbEnergy:      0.4,
+			DescendEnergy:    0.05,
+			Clearance:        2,
+		}).Return(id, nil)
+
+		assert.NoError(t, s.PostDrone(ctx))
+		assert.Equal(t, http.StatusCreated, res.Code)
+
+		var responseBody generated.CreateResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, id, responseBody.Id)
+	})
+
+	t.Run("success test case: without energy costs", func(t *testing.T) {
+		body := `{"model": "T40", "range": 4000, "speed": 10, "climb_rate": 4, "descend_rate": 3, "clearance": 2}`
+		req := httptest.NewRequest(http.MethodPost, "/drone", bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
-		ctx.SetRequest(req)
 
-		err := s.GetEstateIdStats(ctx, validEstateID)
-		assert.NoError(t, err)
-		assert.Equal(t, http.StatusNotFound, res.Code)
+		mockRepo.EXPECT().CreateDrone(gomock.Any(), repository.Drone{
+			Model:       "T40",
+			Range:       4000,
+			Speed:       10,
+			ClimbRate:   4,
+			DescendRate: 3,
+			Clearance:   2,
+		}).Return(uuid.New().String(), nil)
+
+		assert.NoError(t, s.PostDrone(ctx))
+		assert.Equal(t, http.StatusCreated, res.Code)
 	})
+}
+
+func Test_GetDrone(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
 
 	t.Run("failed test case: repository error", func(t *testing.T) {
-		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID}, nil)
-		mockRepo.EXPECT().GetEstateStats(gomock.Any(), validEstateID).Return(repository.Stats{}, errors.New("database error"))
+		mockRepo.EXPECT().GetDrones(gomock.Any()).Return(nil, errors.New("db error"))
 
-		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/stats", nil)
+		req := httptest.NewRequest(http.MethodGet, "/drone", nil)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
-		ctx.SetRequest(req)
 
-		err := s.GetEstateIdStats(ctx, validEstateID)
-		assert.NoError(t, err)
+		assert.NoError(t, s.GetDrone(ctx))
 		assert.Equal(t, http.StatusInternalServerError, res.Code)
 	})
 
 	t.Run("success case", func(t *testing.T) {
-		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID}, nil)
-		mockRepo.EXPECT().GetEstateStats(gomock.Any(), validEstateID).Return(repository.Stats{
-			TotalTrees: 100,
-			MaxHeight:  30,
-			MinHeight:  5,
-			Median:     15,
+		mockRepo.EXPECT().GetDrones(gomock.Any()).Return([]repository.Drone{
+			{ID: "drone-1", Model: "T40", Range: 4000, Speed: 10, ClimbRate: 4, DescendRate: 3, Clearance: 2},
 		}, nil)
 
-		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/stats", nil)
+		req := httptest.NewRequest(http.MethodGet, "/drone", nil)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
-		ctx.SetRequest(req)
 
-		err := s.GetEstateIdStats(ctx, validEstateID)
-		assert.NoError(t, err)
+		assert.NoError(t, s.GetDrone(ctx))
 		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.ListDronesResponse
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, []generated.Drone{
+			{Id: "drone-1", Model: "T40", Range: 4000, Speed: 10, ClimbRate: 4, DescendRate: 3, Clearance: 2},
+		}, responseBody.Drones)
 	})
 }
 
-func Test_GetEstateIdDronePlan(t *testing.T) {
+func Test_GetDroneId(t *testing.T) {
 	e := echo.New()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -245,59 +2830,122 @@ func Test_GetEstateIdDronePlan(t *testing.T) {
 	mockRepo := repository.NewMockRepositoryInterface(ctrl)
 	s := &Server{Repository: mockRepo}
 
-	validEstateID := uuid.New().String()
+	validDroneID := uuid.New().String()
 
-	t.Run("failed test case: estate not found", func(t *testing.T) {
-		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, sql.ErrNoRows)
+	t.Run("failed test case: invalid drone ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/drone/invalid-uuid", nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
 
-		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan", nil)
+		assert.NoError(t, s.GetDroneId(ctx, "invalid-uuid"))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: drone not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetDroneByID(gomock.Any(), validDroneID).Return(repository.Drone{}, sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodGet, "/drone/"+validDroneID, nil)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
-		ctx.SetRequest(req)
 
-		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{})
-		assert.NoError(t, err)
+		assert.NoError(t, s.GetDroneId(ctx, validDroneID))
 		assert.Equal(t, http.StatusNotFound, res.Code)
 	})
 
-	t.Run("failed test case: internal server error on GetEstateByID", func(t *testing.T) {
-		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{}, errors.New("database error"))
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().GetDroneByID(gomock.Any(), validDroneID).Return(repository.Drone{ID: validDroneID, Model: "T40", Range: 4000}, nil)
 
-		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan", nil)
+		req := httptest.NewRequest(http.MethodGet, "/drone/"+validDroneID, nil)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
-		ctx.SetRequest(req)
 
-		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{})
-		assert.NoError(t, err)
-		assert.Equal(t, http.StatusInternalServerError, res.Code)
+		assert.NoError(t, s.GetDroneId(ctx, validDroneID))
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var responseBody generated.Drone
+		json.Unmarshal(res.Body.Bytes(), &responseBody)
+		assert.Equal(t, generated.Drone{Id: validDroneID, Model: "T40", Range: 4000}, responseBody)
 	})
+}
 
-	t.Run("failed test case: internal server error on GetEstateTrees", func(t *testing.T) {
-		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID}, nil)
-		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return(nil, errors.New("database error"))
+func Test_PutDroneId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan", nil)
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validDroneID := uuid.New().String()
+	body := `{"model": "T40", "range": 4000, "speed": 10, "climb_rate": 4, "descend_rate": 3,
+		"horizontal_energy": 0.1, "climb_energy": 0.4, "descend_energy": 0.05, "clearance": 2}`
+	drone := repository.Drone{ID: validDroneID, Model: "T40", Range: 4000, Speed: 10, ClimbRate: 4, DescendRate: 3,
+		HorizontalEnergy: 0.1, ClimbEnergy: 0.4, DescendEnergy: 0.05, Clearance: 2}
+
+	t.Run("failed test case: invalid request body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/drone/"+validDroneID, bytes.NewReader([]byte(`{"model": ""}`)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
-		ctx.SetRequest(req)
 
-		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{})
-		assert.NoError(t, err)
-		assert.Equal(t, http.StatusInternalServerError, res.Code)
+		assert.NoError(t, s.PutDroneId(ctx, validDroneID))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("failed test case: drone not found", func(t *testing.T) {
+		mockRepo.EXPECT().UpdateDrone(gomock.Any(), drone).Return(sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodPut, "/drone/"+validDroneID, bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.PutDroneId(ctx, validDroneID))
+		assert.Equal(t, http.StatusNotFound, res.Code)
 	})
 
 	t.Run("success case", func(t *testing.T) {
-		mockRepo.EXPECT().GetEstateByID(gomock.Any(), validEstateID).Return(repository.Estate{ID: validEstateID}, nil)
-		mockRepo.EXPECT().GetEstateTrees(gomock.Any(), validEstateID).Return(nil, sql.ErrNoRows)
+		mockRepo.EXPECT().UpdateDrone(gomock.Any(), drone).Return(nil)
 
-		req := httptest.NewRequest(http.MethodGet, "/estate/"+validEstateID+"/drone-plan", nil)
+		req := httptest.NewRequest(http.MethodPut, "/drone/"+validDroneID, bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		res := httptest.NewRecorder()
 		ctx := e.NewContext(req, res)
-		ctx.SetRequest(req)
 
-		err := s.GetEstateIdDronePlan(ctx, validEstateID, generated.GetEstateIdDronePlanParams{})
-		assert.NoError(t, err)
+		assert.NoError(t, s.PutDroneId(ctx, validDroneID))
 		assert.Equal(t, http.StatusOK, res.Code)
 	})
 }
+
+func Test_DeleteDroneId(t *testing.T) {
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repository.NewMockRepositoryInterface(ctrl)
+	s := &Server{Repository: mockRepo}
+
+	validDroneID := uuid.New().String()
+
+	t.Run("failed test case: drone not found", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteDrone(gomock.Any(), validDroneID).Return(sql.ErrNoRows)
+
+		req := httptest.NewRequest(http.MethodDelete, "/drone/"+validDroneID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.DeleteDroneId(ctx, validDroneID))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("success case", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteDrone(gomock.Any(), validDroneID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/drone/"+validDroneID, nil)
+		res := httptest.NewRecorder()
+		ctx := e.NewContext(req, res)
+
+		assert.NoError(t, s.DeleteDroneId(ctx, validDroneID))
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	})
+}
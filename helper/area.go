@@ -0,0 +1,371 @@
+package helper
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+)
+
+var ErrNoRoute = errors.New("no route around the no-fly areas")
+
+// area is a rectangle of plots the drone must not fly over, or must climb
+// over like a tree when it is an obstacle of the given height.
+type area struct {
+	minX   int
+	minY   int
+	maxX   int
+	maxY   int
+	height int
+	noFly  bool
+}
+
+func newAreas(restricted []repository.RestrictedArea) []area {
+	areas := make([]area, 0, len(restricted))
+	for _, r := range restricted {
+		areas = append(areas, area{
+			minX:   r.X,
+			minY:   r.Y,
+			maxX:   r.X + r.Length - 1,
+			maxY:   r.Y + r.Width - 1,
+			height: r.Height,
+			noFly:  r.Kind == repository.NoFlyArea,
+		})
+	}
+
+	return areas
+}
+
+func (a area) contains(x, y int) bool {
+	return x >= a.minX && x <= a.maxX && y >= a.minY && y <= a.maxY
+}
+
+// crosses reports whether the straight segment between two plots of the
+// same row or column goes over the area.
+func (a area) crosses(from, to Rest) bool {
+	return max(from.X, to.X) >= a.minX && min(from.X, to.X) <= a.maxX &&
+		max(from.Y, to.Y) >= a.minY && min(from.Y, to.Y) <= a.maxY
+}
+
+// span returns the offsets of the first and last plots of the run inside
+// the area, it reports false when the run does not cross it.
+func (a area) span(run Run) (int, int, bool) {
+	line, from, step := run.Y, run.X, run.DX
+	lineMin, lineMax, lo, hi := a.minY, a.maxY, a.minX, a.maxX
+	if run.DX == 0 && run.DY != 0 {
+		line, from, step = run.X, run.Y, run.DY
+		lineMin, lineMax, lo, hi = a.minX, a.maxX, a.minY, a.maxY
+	}
+	if run.Count == 0 || line < lineMin || line > lineMax {
+		return 0, 0, false
+	}
+	if step == 0 {
+		return 0, 0, from >= lo && from <= hi
+	}
+
+	lo, hi = (lo-from)*step, (hi-from)*step
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	lo, hi = max(lo, 0), min(hi, run.Count-1)
+
+	return lo, hi, lo <= hi
+}
+
+// height returns the height to clear over a plot, the tallest of its tree
+// and of the obstacles over it.
+func (s *Stats) height(x, y int) int {
+	height := s.index.height(x, y)
+	for _, a := range s.areas {
+		if !a.noFly && a.contains(x, y) {
+			height = max(height, a.height)
+		}
+	}
+
+	return height
+}
+
+// noFly reports whether a plot is in a no-fly area.
+func (s *Stats) noFly(x, y int) bool {
+	for _, a := range s.areas {
+		if a.noFly && a.contains(x, y) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// span is a stretch of a run, from offset lo to offset hi, over an obstacle
+// of the given height or over plots to skip.
+type span struct {
+	lo     int
+	hi     int
+	height int
+	skip   bool
+}
+
+// spans returns the stretches of a run over the restricted areas and over
+// the plots outside the boundary of the estate.
+func (s *Stats) spans(run Run) []span {
+	spans := s.outside(run)
+	for _, a := range s.areas {
+		if lo, hi, ok := a.span(run); ok {
+			spans = append(spans, span{lo: lo, hi: hi, height: a.height, skip: a.noFly})
+		}
+	}
+
+	return spans
+}
+
+// levelsAcross splits a run crossing some spans into the stretches of
+// plots flown at the same altitude, leaving out the plots to skip. Over an
+// uneven ground, every plot is a stretch of its own so the distance to land
+// never decreases along a stretch.
+func (s *Stats) levelsAcross(run Run, spans []span, visit func(run Run, altitude int) error) error {
+	breaks := []int{0, run.Count}
+	for _, sp := range spans {
+		breaks = append(breaks, sp.lo, sp.hi+1)
+	}
+	if s.Elevation != nil {
+		for offset := 1; offset < run.Count; offset++ {
+			breaks = append(breaks, offset)
+		}
+	}
+
+	trees := make(map[int]int)
+	for _, tree := range s.index.along(run) {
+		offset := run.offset(tree.X, tree.Y)
+		trees[offset] = tree.Height
+		breaks = append(breaks, offset, offset+1)
+	}
+	sort.Ints(breaks)
+
+	// pending stretch, not visited yet in case the next one is at the
+	// same altitude
+	from, to, level := 0, 0, 0
+	flush := func() error {
+		if from == to {
+			return nil
+		}
+
+		return visit(run.skip(from, to-from), level)
+	}
+
+	for k := 0; k+1 < len(breaks); k++ {
+		lo, hi := breaks[k], breaks[k+1]
+		if lo == hi {
+			continue
+		}
+
+		height, skip := trees[lo], false
+		for _, sp := range spans {
+			if sp.lo <= lo && hi-1 <= sp.hi {
+				skip = skip || sp.skip
+				height = max(height, sp.height)
+			}
+		}
+
+		if skip {
+			if err := flush(); err != nil {
+				return err
+			}
+			from, to = hi, hi
+			continue
+		}
+
+		plot := run.skip(lo, 1)
+		if altitude := s.ground(plot.X, plot.Y) + s.altitude(height); altitude != level || from == to || s.Elevation != nil {
+			if err := flush(); err != nil {
+				return err
+			}
+			from, level = lo, altitude
+		}
+		to = hi
+	}
+
+	return flush()
+}
+
+// route returns the turns of the shortest path between two plots that
+// does not go over a no-fly area, ending with the destination. The path
+// stays within the estate, or the rectangle covering the estate and both
+// plots when one of them is outside of it. It reports false when the
+// destination cannot be reached.
+func (s *Stats) route(from, to Rest) ([]Rest, bool) {
+	if corner := (Rest{X: to.X, Y: from.Y}); s.clear(from, corner) && s.clear(corner, to) {
+		return []Rest{corner, to}, true
+	}
+	if corner := (Rest{X: from.X, Y: to.Y}); s.clear(from, corner) && s.clear(corner, to) {
+		return []Rest{corner, to}, true
+	}
+
+	return s.detourRoute(from, to)
+}
+
+// routeLength returns the number of plots flown along the route between two
+// plots, or -1 when there is none.
+func (s *Stats) routeLength(from, to Rest) int {
+	if corner := (Rest{X: to.X, Y: from.Y}); s.clear(from, corner) && s.clear(corner, to) {
+		return abs(to.X-from.X) + abs(to.Y-from.Y)
+	}
+
+	turns, ok := s.route(from, to)
+	if !ok {
+		return -1
+	}
+
+	length := 0
+	for _, turn := range turns {
+		length += abs(turn.X-from.X) + abs(turn.Y-from.Y)
+		from = turn
+	}
+
+	return length
+}
+
+// clear reports whether the straight segment between two plots of the same
+// row or column avoids every no-fly area.
+func (s *Stats) clear(from, to Rest) bool {
+	for _, a := range s.areas {
+		if a.noFly && a.crosses(from, to) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// detourRoute finds the shortest route around the no-fly areas with a
+// Dijkstra search over the grid of the rows and columns right next to
+// them, where a shortest route can always be found. Among the shortest
+// routes, the one with the fewest turns is kept.
+func (s *Stats) detourRoute(from, to Rest) ([]Rest, bool) {
+	if from == to {
+		return []Rest{to}, !s.noFly(to.X, to.Y)
+	}
+
+	minX, maxX := min(1, from.X, to.X), max(s.Estate.Length, from.X, to.X)
+	minY, maxY := min(1, from.Y, to.Y), max(s.Estate.Width, from.Y, to.Y)
+
+	xs := []int{minX, maxX, from.X, to.X}
+	ys := []int{minY, maxY, from.Y, to.Y}
+	for _, a := range s.areas {
+		if a.noFly {
+			xs = append(xs, a.minX-1, a.maxX+1)
+			ys = append(ys, a.minY-1, a.maxY+1)
+		}
+	}
+	xs, ys = grid(xs, minX, maxX), grid(ys, minY, maxY)
+
+	// a state is a plot of the grid and whether the drone reached it
+	// flying along the width
+	state := func(i, j, along int) int { return (i*len(ys)+j)*2 + along }
+	plot := func(n int) Rest { return Rest{X: xs[n/2/len(ys)], Y: ys[n/2%len(ys)]} }
+	i, j := sort.SearchInts(xs, from.X), sort.SearchInts(ys, from.Y)
+
+	costs := make([]routeItem, len(xs)*len(ys)*2)
+	previous := make([]int, len(costs))
+	for n := range costs {
+		costs[n], previous[n] = routeItem{distance: math.MaxInt}, -1
+	}
+
+	queue := &routeQueue{}
+	for along := 0; along < 2; along++ {
+		n := state(i, j, along)
+		costs[n] = routeItem{node: n}
+		heap.Push(queue, costs[n])
+	}
+
+	last := -1
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(routeItem)
+		if costs[item.node].less(item) {
+			continue
+		}
+		if plot(item.node) == to {
+			last = item.node
+			break
+		}
+
+		i, j, along := item.node/2/len(ys), item.node/2%len(ys), item.node%2
+		for _, next := range [][3]int{{i - 1, j, 0}, {i + 1, j, 0}, {i, j - 1, 1}, {i, j + 1, 1}} {
+			if next[0] < 0 || next[0] >= len(xs) || next[1] < 0 || next[1] >= len(ys) {
+				continue
+			}
+
+			n := state(next[0], next[1], next[2])
+			a, b := plot(item.node), plot(n)
+			cost := routeItem{
+				node:     n,
+				distance: item.distance + abs(a.X-b.X) + abs(a.Y-b.Y),
+				turns:    item.turns,
+			}
+			if next[2] != along {
+				cost.turns++
+			}
+			if !cost.less(costs[n]) || !s.clear(a, b) {
+				continue
+			}
+
+			costs[n], previous[n] = cost, item.node
+			heap.Push(queue, cost)
+		}
+	}
+	if last < 0 {
+		return nil, false
+	}
+
+	// walk back from the destination, keeping only the turns
+	turns := []Rest{to}
+	for n := last; previous[n] >= 0; n = previous[n] {
+		if p := previous[n]; previous[p] >= 0 && p%2 != n%2 {
+			turns = append(turns, plot(p))
+		}
+	}
+	for i, j := 0, len(turns)-1; i < j; i, j = i+1, j-1 {
+		turns[i], turns[j] = turns[j], turns[i]
+	}
+
+	return turns, true
+}
+
+// grid sorts the coordinates within [lo, hi] and removes the duplicates.
+func grid(coordinates []int, lo, hi int) []int {
+	sort.Ints(coordinates)
+	unique := coordinates[:0]
+	for _, c := range coordinates {
+		if c < lo || c > hi || (len(unique) > 0 && unique[len(unique)-1] == c) {
+			continue
+		}
+		unique = append(unique, c)
+	}
+
+	return unique
+}
+
+type routeItem struct {
+	node     int
+	distance int
+	turns    int
+}
+
+func (r routeItem) less(other routeItem) bool {
+	return r.distance < other.distance || (r.distance == other.distance && r.turns < other.turns)
+}
+
+// routeQueue is the priority queue of the route search, closest first.
+type routeQueue []routeItem
+
+func (q routeQueue) Len() int            { return len(q) }
+func (q routeQueue) Less(i, j int) bool  { return q[i].less(q[j]) }
+func (q routeQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *routeQueue) Push(x interface{}) { *q = append(*q, x.(routeItem)) }
+func (q *routeQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
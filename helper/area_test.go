@@ -0,0 +1,147 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CalculateTotalDistanceWithAreas(t *testing.T) {
+	t.Run("climb over an obstacle like a tree", func(t *testing.T) {
+		withTree := Stats{
+			Estate: repository.Estate{Length: 5, Width: 2},
+			Trees:  Trees{repository.Tree{X: 3, Y: 1, Height: 10}, repository.Tree{X: 3, Y: 2, Height: 10}},
+		}
+		withObstacle := Stats{
+			Estate: repository.Estate{Length: 5, Width: 2},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.ObstacleArea, X: 3, Y: 1, Length: 1, Width: 2, Height: 10},
+			},
+		}
+
+		assert.NoError(t, withTree.CalculateTotalDistance(context.Background()))
+		assert.NoError(t, withObstacle.CalculateTotalDistance(context.Background()))
+		assert.Equal(t, withTree.Distance, withObstacle.Distance)
+		assert.Equal(t, withTree.Flight, withObstacle.Flight)
+	})
+
+	t.Run("obstacle taller than a tree", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 3, Width: 1},
+			Trees:  Trees{repository.Tree{X: 2, Y: 1, Height: 5}},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.ObstacleArea, X: 2, Y: 1, Length: 1, Width: 1, Height: 20},
+			},
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+		assert.Equal(t, 1+(10+20)+(10+20)+1, stats.Distance)
+	})
+
+	t.Run("fly around a no-fly plot", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 3, Width: 3},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.NoFlyArea, X: 2, Y: 2, Length: 1, Width: 1},
+			},
+			RecordWaypoints: true,
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+		for _, waypoint := range stats.Waypoints {
+			assert.NotEqual(t, Rest{X: 2, Y: 2}, Rest{X: waypoint.X, Y: waypoint.Y})
+		}
+
+		// 6 hops between the 8 plots flown over at 1 meter, the 2 plots
+		// from (3, 2) to (1, 2) are flown around the no-fly plot in 4
+		assert.Equal(t, 1+10*6+10*4+1, stats.Distance)
+		assert.Equal(t, Rest{X: 3, Y: 3}, stats.Legs[0].End)
+	})
+
+	t.Run("rest before flying around a no-fly area", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 3, Width: 3},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.NoFlyArea, X: 2, Y: 2, Length: 1, Width: 1},
+			},
+			CountRests:  true,
+			MaxDistance: 50,
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+		for _, leg := range stats.Legs {
+			assert.LessOrEqual(t, leg.Distance, 50)
+		}
+	})
+
+	t.Run("fleet sections around a no-fly row", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 4, Width: 3},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.NoFlyArea, X: 1, Y: 2, Length: 4, Width: 1},
+			},
+		}
+
+		fleet, err := stats.CalculateFleetDistance(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, Rest{X: 1, Y: 1}, fleet[0].Legs[0].Start)
+		assert.Equal(t, Rest{X: 4, Y: 1}, fleet[0].Legs[0].End)
+		assert.Equal(t, Rest{X: 1, Y: 3}, fleet[1].Legs[0].Start)
+		assert.Equal(t, Rest{X: 4, Y: 3}, fleet[1].Legs[0].End)
+	})
+
+	t.Run("no route to the other side of a no-fly area", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 3, Width: 3},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.NoFlyArea, X: 2, Y: 1, Length: 1, Width: 3},
+			},
+		}
+
+		assert.ErrorIs(t, stats.CalculateTotalDistance(context.Background()), ErrNoRoute)
+	})
+
+	t.Run("no route over an estate all in a no-fly area", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 3, Width: 2},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.NoFlyArea, X: 1, Y: 1, Length: 3, Width: 2},
+			},
+		}
+
+		assert.ErrorIs(t, stats.CalculateTotalDistance(context.Background()), ErrNoRoute)
+	})
+}
+
+func Test_Route(t *testing.T) {
+	stats := Stats{
+		Estate: repository.Estate{Length: 10, Width: 10},
+		Areas: []repository.RestrictedArea{
+			{Kind: repository.NoFlyArea, X: 3, Y: 1, Length: 2, Width: 6},
+			{Kind: repository.NoFlyArea, X: 6, Y: 4, Length: 1, Width: 7},
+			{Kind: repository.ObstacleArea, X: 1, Y: 1, Length: 10, Width: 10, Height: 5},
+		},
+	}
+	stats.prepare()
+
+	t.Run("straight along the length and the width", func(t *testing.T) {
+		turns, ok := stats.route(Rest{X: 1, Y: 9}, Rest{X: 5, Y: 1})
+		assert.True(t, ok)
+		assert.Equal(t, []Rest{{X: 5, Y: 9}, {X: 5, Y: 1}}, turns)
+	})
+
+	t.Run("around both no-fly areas", func(t *testing.T) {
+		turns, ok := stats.route(Rest{X: 1, Y: 1}, Rest{X: 8, Y: 8})
+		assert.True(t, ok)
+		assert.Equal(t, []Rest{{X: 1, Y: 7}, {X: 5, Y: 7}, {X: 5, Y: 3}, {X: 8, Y: 3}, {X: 8, Y: 8}}, turns)
+		assert.Equal(t, 6+4+4+3+5, stats.routeLength(Rest{X: 1, Y: 1}, Rest{X: 8, Y: 8}))
+	})
+
+	t.Run("into a no-fly area", func(t *testing.T) {
+		_, ok := stats.route(Rest{X: 1, Y: 1}, Rest{X: 3, Y: 3})
+		assert.False(t, ok)
+		assert.Equal(t, -1, stats.routeLength(Rest{X: 1, Y: 1}, Rest{X: 3, Y: 3}))
+	})
+}
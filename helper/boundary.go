@@ -0,0 +1,164 @@
+package helper
+
+import (
+	"math"
+	"sort"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+)
+
+// MaxBoundaryVertices caps the number of corners of an estate boundary.
+const MaxBoundaryVertices = 1000
+
+// ValidBoundary reports whether a boundary is a polygon of at least 3 and
+// at most MaxBoundaryVertices corners within the rectangle of the estate,
+// enclosing at least one plot.
+func ValidBoundary(estate repository.Estate, boundary repository.Boundary) bool {
+	if len(boundary) < 3 || len(boundary) > MaxBoundaryVertices {
+		return false
+	}
+
+	for _, vertex := range boundary {
+		if vertex.X < 0 || vertex.Y < 0 || vertex.X > estate.Length || vertex.Y > estate.Width {
+			return false
+		}
+	}
+
+	estate.Boundary = boundary
+	return CountPlots(estate) > 0
+}
+
+// InsideEstate reports whether plot (x, y) is part of the estate: within
+// its rectangle and, when it has a boundary, with its center inside the
+// boundary.
+func InsideEstate(estate repository.Estate, x, y int) bool {
+	if x <= 0 || y <= 0 || x > estate.Length || y > estate.Width {
+		return false
+	}
+	if estate.Boundary == nil {
+		return true
+	}
+
+	for _, inside := range insideRanges(estate.Boundary, y, false) {
+		if x >= inside[0] && x <= inside[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CountPlots returns the number of plots of the estate.
+func CountPlots(estate repository.Estate) int {
+	if estate.Boundary == nil {
+		return estate.Length * estate.Width
+	}
+
+	count := 0
+	for y := 1; y <= estate.Width; y++ {
+		for _, inside := range insideRanges(estate.Boundary, y, false) {
+			count += min(inside[1], estate.Length) - max(inside[0], 1) + 1
+		}
+	}
+
+	return count
+}
+
+// InsideTrees returns the trees on the plots of the estate.
+func InsideTrees(estate repository.Estate, trees []repository.Tree) []repository.Tree {
+	if estate.Boundary == nil {
+		return trees
+	}
+
+	inside := make([]repository.Tree, 0, len(trees))
+	for _, tree := range trees {
+		if InsideEstate(estate, tree.X, tree.Y) {
+			inside = append(inside, tree)
+		}
+	}
+
+	return inside
+}
+
+// insideRanges returns the first and last plots of the stretches of row y,
+// or of column y when vertical, with their center inside the boundary. The
+// center of a plot is never on a corner of the boundary, so the boundary
+// crosses the line at the centers an even number of times.
+func insideRanges(boundary repository.Boundary, line int, vertical bool) [][2]int {
+	v := float64(line) - 0.5
+	crossings := make([]float64, 0)
+	for i := range boundary {
+		a, b := boundary[i], boundary[(i+1)%len(boundary)]
+		if vertical {
+			a, b = repository.Vertex{X: a.Y, Y: a.X}, repository.Vertex{X: b.Y, Y: b.X}
+		}
+		if (float64(a.Y) < v) == (float64(b.Y) < v) {
+			continue
+		}
+
+		crossings = append(crossings, float64(a.X)+(v-float64(a.Y))*float64(b.X-a.X)/float64(b.Y-a.Y))
+	}
+	sort.Float64s(crossings)
+
+	// plot x is inside when its center x - 0.5 is strictly between two
+	// crossings
+	ranges := make([][2]int, 0, len(crossings)/2)
+	for i := 0; i+1 < len(crossings); i += 2 {
+		first := int(math.Floor(crossings[i]+0.5)) + 1
+		last := int(math.Ceil(crossings[i+1]+0.5)) - 1
+		if first <= last {
+			ranges = append(ranges, [2]int{first, last})
+		}
+	}
+
+	return ranges
+}
+
+// outside returns the spans of the run over the plots outside the boundary
+// of the estate, flown over without being surveyed.
+func (s *Stats) outside(run Run) []span {
+	if s.Estate.Boundary == nil || run.Count == 0 {
+		return nil
+	}
+
+	line, from, step := run.Y, run.X, run.DX
+	vertical := run.DX == 0 && run.DY != 0
+	if vertical {
+		line, from, step = run.X, run.Y, run.DY
+	}
+	if step == 0 {
+		if InsideEstate(s.Estate, run.X, run.Y) {
+			return nil
+		}
+		return []span{{lo: 0, hi: 0, skip: true}}
+	}
+
+	// offsets of the run inside the boundary, in flight order
+	inside := insideRanges(s.Estate.Boundary, line, vertical)
+	offsets := make([][2]int, 0, len(inside))
+	for _, r := range inside {
+		lo, hi := (r[0]-from)*step, (r[1]-from)*step
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		offsets = append(offsets, [2]int{lo, hi})
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i][0] < offsets[j][0] })
+
+	spans := make([]span, 0, len(offsets)+1)
+	next := 0
+	for _, r := range offsets {
+		if r[0] > next {
+			spans = append(spans, span{lo: next, hi: min(r[0], run.Count) - 1, skip: true})
+		}
+		next = max(next, r[1]+1)
+		if next >= run.Count {
+			break
+		}
+	}
+	if next < run.Count {
+		spans = append(spans, span{lo: next, hi: run.Count - 1, skip: true})
+	}
+
+	return spans
+}
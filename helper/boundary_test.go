@@ -0,0 +1,75 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Boundary(t *testing.T) {
+	// U-shaped estate, the plots (2, 2) to (3, 3) are outside
+	estate := repository.Estate{
+		Length: 4,
+		Width:  3,
+		Boundary: repository.Boundary{
+			{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 4, Y: 3}, {X: 3, Y: 3},
+			{X: 3, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 3}, {X: 0, Y: 3},
+		},
+	}
+
+	t.Run("plots with their center inside", func(t *testing.T) {
+		assert.True(t, InsideEstate(estate, 2, 1))
+		assert.True(t, InsideEstate(estate, 4, 3))
+		assert.False(t, InsideEstate(estate, 2, 2))
+		assert.False(t, InsideEstate(estate, 3, 3))
+		assert.False(t, InsideEstate(estate, 5, 1))
+		assert.Equal(t, 4+2+2, CountPlots(estate))
+		assert.Equal(t, 16, CountPlots(repository.Estate{Length: 4, Width: 4}))
+	})
+
+	t.Run("valid boundary", func(t *testing.T) {
+		assert.True(t, ValidBoundary(estate, estate.Boundary))
+		assert.False(t, ValidBoundary(estate, repository.Boundary{{X: 0, Y: 0}, {X: 4, Y: 0}}))
+		assert.False(t, ValidBoundary(estate, repository.Boundary{{X: 0, Y: 0}, {X: 5, Y: 0}, {X: 4, Y: 3}}))
+		assert.False(t, ValidBoundary(estate, repository.Boundary{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 4, Y: 0}}))
+	})
+
+	t.Run("trees inside", func(t *testing.T) {
+		trees := []repository.Tree{
+			{X: 1, Y: 1, Height: 10},
+			{X: 2, Y: 2, Height: 30},
+			{X: 4, Y: 2, Height: 5},
+			{X: 1, Y: 3, Height: 8},
+		}
+
+		inside := InsideTrees(estate, trees)
+		assert.Equal(t, []repository.Tree{trees[0], trees[2], trees[3]}, inside)
+	})
+
+	t.Run("plan over the plots inside only", func(t *testing.T) {
+		stats := Stats{Estate: estate, RecordWaypoints: true}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+		for _, waypoint := range stats.Waypoints {
+			assert.True(t, InsideEstate(estate, waypoint.X, waypoint.Y))
+		}
+
+		// the drone flies over the 2 plots outside from (4, 2) to (1, 2),
+		// and from (1, 3) to (4, 3)
+		assert.Equal(t, 1+10*3+10+10*3+10+10*3+1, stats.Distance)
+		assert.Equal(t, Rest{X: 4, Y: 3}, stats.Legs[0].End)
+	})
+
+	t.Run("no route without plots inside", func(t *testing.T) {
+		// a boundary along the bottom edge encloses no plot center
+		outside := repository.Estate{Length: 4, Width: 3, Boundary: repository.Boundary{
+			{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 2, Y: 0},
+		}}
+		stats := Stats{Estate: outside}
+
+		assert.Zero(t, CountPlots(outside))
+		assert.ErrorIs(t, stats.CalculateTotalDistance(context.Background()), ErrNoRoute)
+	})
+}
@@ -0,0 +1,51 @@
+package helper
+
+// Flight splits a flown distance in meters between level flight, climbs and
+// descents.
+type Flight struct {
+	Horizontal int
+	Climb      int
+	Descent    int
+}
+
+// Performance holds the speeds in meters per second and the energy costs in
+// watt-hours per meter of a drone.
+type Performance struct {
+	Speed            float64
+	ClimbRate        float64
+	DescendRate      float64
+	HorizontalEnergy float64
+	ClimbEnergy      float64
+	DescendEnergy    float64
+}
+
+// Estimate is the time in seconds and the battery energy in watt-hours
+// needed for a flight.
+type Estimate struct {
+	Duration float64
+	Energy   float64
+}
+
+// HasEnergy reports whether the energy costs of the drone are known, they
+// all default to 0 when it is registered without them.
+func (p Performance) HasEnergy() bool {
+	return p.HorizontalEnergy > 0 || p.ClimbEnergy > 0 || p.DescendEnergy > 0
+}
+
+// Estimate returns the time and energy needed by a drone for the flight.
+func (f Flight) Estimate(p Performance) Estimate {
+	horizontal, climb, descent := float64(f.Horizontal), float64(f.Climb), float64(f.Descent)
+
+	return Estimate{
+		Duration: horizontal/p.Speed + climb/p.ClimbRate + descent/p.DescendRate,
+		Energy:   horizontal*p.HorizontalEnergy + climb*p.ClimbEnergy + descent*p.DescendEnergy,
+	}
+}
+
+func (f Flight) add(other Flight) Flight {
+	return Flight{
+		Horizontal: f.Horizontal + other.Horizontal,
+		Climb:      f.Climb + other.Climb,
+		Descent:    f.Descent + other.Descent,
+	}
+}
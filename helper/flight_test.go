@@ -0,0 +1,28 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FlightEstimate(t *testing.T) {
+	performance := Performance{
+		Speed:            10,
+		ClimbRate:        2,
+		DescendRate:      4,
+		HorizontalEnergy: 0.1,
+		ClimbEnergy:      0.5,
+		DescendEnergy:    0.05,
+	}
+
+	estimate := Flight{Horizontal: 200, Climb: 10, Descent: 20}.Estimate(performance)
+
+	assert.InDelta(t, 30, estimate.Duration, 1e-9)
+	assert.InDelta(t, 26, estimate.Energy, 1e-9)
+}
+
+func Test_HasEnergy(t *testing.T) {
+	assert.False(t, Performance{Speed: 10, ClimbRate: 2, DescendRate: 4}.HasEnergy())
+	assert.True(t, Performance{Speed: 10, ClimbRate: 2, DescendRate: 4, ClimbEnergy: 0.5}.HasEnergy())
+}
@@ -0,0 +1,91 @@
+package helper
+
+import (
+	"math"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+)
+
+// EarthRadius is the mean radius of the earth in meters.
+const EarthRadius = 6371008.8
+
+// Location is a WGS84 coordinate in degrees.
+type Location struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// EstatePlotSize returns the plot size of an estate, PlotSize when it is
+// not set.
+func EstatePlotSize(estate repository.Estate) int {
+	if estate.PlotSize > 0 {
+		return estate.PlotSize
+	}
+
+	return PlotSize
+}
+
+// IsGeoReferenced reports whether the estate has a real-world origin.
+func IsGeoReferenced(estate repository.Estate) bool {
+	return estate.OriginLatitude != nil && estate.OriginLongitude != nil
+}
+
+// LocalPoint returns the meters east and north of the estate origin of a
+// point given in plots, the origin (0, 0) being the outer corner of plot
+// (1, 1) and the center of plot (x, y) being (x - 0.5, y - 0.5). The length
+// of the estate is rotated counterclockwise from east by the estate
+// rotation, and its width 90 degrees counterclockwise from the length.
+func LocalPoint(estate repository.Estate, u, v float64) (east, north float64) {
+	size := float64(EstatePlotSize(estate))
+	rotation := estate.Rotation * math.Pi / 180
+
+	east = u*size*math.Cos(rotation) - v*size*math.Sin(rotation)
+	north = u*size*math.Sin(rotation) + v*size*math.Cos(rotation)
+	return east, north
+}
+
+// Locate returns the WGS84 location of a point given in plots, see
+// LocalPoint. It returns false when the estate is not geo-referenced.
+func Locate(estate repository.Estate, u, v float64) (Location, bool) {
+	if !IsGeoReferenced(estate) {
+		return Location{}, false
+	}
+
+	east, north := LocalPoint(estate, u, v)
+	distance := math.Hypot(east, north) / EarthRadius
+	bearing := math.Atan2(east, north)
+	latitude := *estate.OriginLatitude * math.Pi / 180
+	longitude := *estate.OriginLongitude * math.Pi / 180
+
+	// destination point along a great circle from the origin
+	destinationLatitude := math.Asin(math.Sin(latitude)*math.Cos(distance) +
+		math.Cos(latitude)*math.Sin(distance)*math.Cos(bearing))
+	destinationLongitude := longitude + math.Atan2(
+		math.Sin(bearing)*math.Sin(distance)*math.Cos(latitude),
+		math.Cos(distance)-math.Sin(latitude)*math.Sin(destinationLatitude))
+
+	return Location{
+		Latitude:  destinationLatitude * 180 / math.Pi,
+		Longitude: math.Remainder(destinationLongitude*180/math.Pi, 360),
+	}, true
+}
+
+// PlotLocation returns the WGS84 location of the center of a plot.
+func PlotLocation(estate repository.Estate, x, y int) (Location, bool) {
+	return Locate(estate, float64(x)-0.5, float64(y)-0.5)
+}
+
+// PlotCorners returns the WGS84 locations of the corners of a plot,
+// counterclockwise from its corner nearest to the estate origin.
+func PlotCorners(estate repository.Estate, x, y int) ([]Location, bool) {
+	corners := make([]Location, 0, 4)
+	for _, corner := range [][2]float64{{-1, -1}, {0, -1}, {0, 0}, {-1, 0}} {
+		location, ok := Locate(estate, float64(x)+corner[0], float64(y)+corner[1])
+		if !ok {
+			return nil, false
+		}
+		corners = append(corners, location)
+	}
+
+	return corners, true
+}
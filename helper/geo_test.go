@@ -0,0 +1,55 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LocalPoint(t *testing.T) {
+	t.Run("estate laid out east and north", func(t *testing.T) {
+		east, north := LocalPoint(repository.Estate{PlotSize: 20}, 1.5, 0.5)
+		assert.InDelta(t, 30, east, 1e-9)
+		assert.InDelta(t, 10, north, 1e-9)
+	})
+
+	t.Run("rotated estate", func(t *testing.T) {
+		east, north := LocalPoint(repository.Estate{Rotation: 90}, 1, 2)
+		assert.InDelta(t, -20, east, 1e-9)
+		assert.InDelta(t, 10, north, 1e-9)
+	})
+}
+
+func Test_Locate(t *testing.T) {
+	t.Run("estate is not geo-referenced", func(t *testing.T) {
+		_, ok := PlotLocation(repository.Estate{}, 1, 1)
+		assert.False(t, ok)
+	})
+
+	t.Run("plot center", func(t *testing.T) {
+		latitude, longitude := 60.0, 10.0
+		estate := repository.Estate{OriginLatitude: &latitude, OriginLongitude: &longitude}
+
+		location, ok := PlotLocation(estate, 101, 1)
+		assert.True(t, ok)
+		// 1005 meters east and 5 meters north, a degree of longitude is
+		// half as long at 60 degrees north, the great circle bends a
+		// little from the flat approximation
+		assert.InDelta(t, 60+5/(EarthRadius*0.017453292519943295), location.Latitude, 1e-5)
+		assert.InDelta(t, 10+1005/(EarthRadius*0.017453292519943295*0.5), location.Longitude, 1e-5)
+	})
+
+	t.Run("plot corners", func(t *testing.T) {
+		latitude, longitude := 0.0, 0.0
+		estate := repository.Estate{OriginLatitude: &latitude, OriginLongitude: &longitude}
+
+		corners, ok := PlotCorners(estate, 1, 1)
+		assert.True(t, ok)
+		assert.Len(t, corners, 4)
+		assert.Equal(t, Location{}, corners[0])
+		assert.Greater(t, corners[1].Longitude, 0.0)
+		assert.InDelta(t, 0, corners[1].Latitude, 1e-12)
+		assert.Greater(t, corners[3].Latitude, 0.0)
+	})
+}
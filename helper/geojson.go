@@ -0,0 +1,101 @@
+package helper
+
+// FeatureCollection is a GeoJSON feature collection. Coordinates are
+// longitude and latitude when the estate is geo-referenced, and meters east
+// and north of the estate origin otherwise.
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+type Feature struct {
+	Type       string         `json:"type"`
+	Geometry   Geometry       `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+type Geometry struct {
+	Type        string `json:"type"`
+	Coordinates any    `json:"coordinates"`
+}
+
+// GeoJSON returns the estate boundary, one point per tree and, when the
+// waypoints are recorded, the drone path as GeoJSON features.
+func (s *Stats) GeoJSON() FeatureCollection {
+	length, width := float64(s.Estate.Length), float64(s.Estate.Width)
+	boundary := [][]float64{
+		s.position(0, 0),
+		s.position(length, 0),
+		s.position(length, width),
+		s.position(0, width),
+		s.position(0, 0),
+	}
+	if s.Estate.Boundary != nil {
+		boundary = make([][]float64, 0, len(s.Estate.Boundary)+1)
+		for i := 0; i <= len(s.Estate.Boundary); i++ {
+			vertex := s.Estate.Boundary[i%len(s.Estate.Boundary)]
+			boundary = append(boundary, s.position(float64(vertex.X), float64(vertex.Y)))
+		}
+	}
+
+	collection := FeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]Feature, 0, len(s.Trees)+2),
+	}
+	collection.Features = append(collection.Features, Feature{
+		Type:     "Feature",
+		Geometry: Geometry{Type: "Polygon", Coordinates: [][][]float64{boundary}},
+		Properties: map[string]any{
+			"kind":      "estate",
+			"id":        s.Estate.ID,
+			"length":    s.Estate.Length,
+			"width":     s.Estate.Width,
+			"plot_size": EstatePlotSize(s.Estate),
+		},
+	})
+
+	for _, tree := range s.Trees {
+		collection.Features = append(collection.Features, Feature{
+			Type:     "Feature",
+			Geometry: Geometry{Type: "Point", Coordinates: s.position(float64(tree.X)-0.5, float64(tree.Y)-0.5)},
+			Properties: map[string]any{
+				"kind":   "tree",
+				"id":     tree.ID,
+				"x":      tree.X,
+				"y":      tree.Y,
+				"height": tree.Height,
+			},
+		})
+	}
+
+	if len(s.Waypoints) > 0 {
+		path := make([][]float64, 0, len(s.Waypoints))
+		for _, waypoint := range s.Waypoints {
+			position := s.position(float64(waypoint.X)-0.5, float64(waypoint.Y)-0.5)
+			path = append(path, append(position, float64(waypoint.Altitude)))
+		}
+
+		collection.Features = append(collection.Features, Feature{
+			Type:     "Feature",
+			Geometry: Geometry{Type: "LineString", Coordinates: path},
+			Properties: map[string]any{
+				"kind":           "drone_path",
+				"strategy":       s.Traversal.Name(),
+				"distance":       s.Distance,
+				"total_distance": s.TotalDistance,
+			},
+		})
+	}
+
+	return collection
+}
+
+// position returns the GeoJSON position of a point given in plots.
+func (s *Stats) position(u, v float64) []float64 {
+	if location, ok := Locate(s.Estate, u, v); ok {
+		return []float64{location.Longitude, location.Latitude}
+	}
+
+	east, north := LocalPoint(s.Estate, u, v)
+	return []float64{east, north}
+}
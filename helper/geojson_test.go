@@ -0,0 +1,59 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GeoJSON(t *testing.T) {
+	t.Run("local coordinates", func(t *testing.T) {
+		stats := Stats{
+			Estate:          repository.Estate{ID: "estate-1", Length: 2, Width: 1},
+			Trees:           Trees{repository.Tree{ID: "tree-1", X: 2, Y: 1, Height: 5}},
+			RecordWaypoints: true,
+		}
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+		collection := stats.GeoJSON()
+		assert.Equal(t, "FeatureCollection", collection.Type)
+		assert.Len(t, collection.Features, 3)
+
+		estate := collection.Features[0]
+		assert.Equal(t, "Polygon", estate.Geometry.Type)
+		assert.Equal(t, [][][]float64{{{0, 0}, {20, 0}, {20, 10}, {0, 10}, {0, 0}}}, estate.Geometry.Coordinates)
+
+		tree := collection.Features[1]
+		assert.Equal(t, "Point", tree.Geometry.Type)
+		assert.Equal(t, []float64{15, 5}, tree.Geometry.Coordinates)
+		assert.Equal(t, "tree-1", tree.Properties["id"])
+		assert.Equal(t, 5, tree.Properties["height"])
+
+		path := collection.Features[2]
+		assert.Equal(t, "LineString", path.Geometry.Type)
+		assert.Equal(t, [][]float64{{5, 5, 0}, {5, 5, 1}, {5, 5, 6}, {15, 5, 6}, {15, 5, 0}}, path.Geometry.Coordinates)
+		assert.Equal(t, 22, path.Properties["distance"])
+	})
+
+	t.Run("geo-referenced coordinates", func(t *testing.T) {
+		latitude, longitude := 1.0, 100.0
+		stats := Stats{
+			Estate: repository.Estate{
+				Length:          2,
+				Width:           1,
+				OriginLatitude:  &latitude,
+				OriginLongitude: &longitude,
+			},
+		}
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+		collection := stats.GeoJSON()
+		assert.Len(t, collection.Features, 1)
+
+		boundary := collection.Features[0].Geometry.Coordinates.([][][]float64)[0]
+		assert.Equal(t, []float64{100, 1}, boundary[0])
+		assert.Greater(t, boundary[1][0], 100.0)
+	})
+}
@@ -1,20 +1,71 @@
 package helper
 
 import (
+	"context"
+	"errors"
 	"math"
+	"sort"
 
 	"github.com/SawitProRecruitment/UserService/repository"
 )
 
+const (
+	// PlotSize is the default length in meters of the side of a plot.
+	PlotSize = 10
+
+	// MaxPlanSize caps the number of waypoints and legs of a single plan.
+	MaxPlanSize = 1_000_000
+)
+
+// DefaultDroneProfile is the drone profile of the estates without one: 1
+// meter above the trees, taking off and landing from 1 meter.
+var DefaultDroneProfile = repository.DroneProfile{
+	Clearance:       1,
+	TakeoffAltitude: 1,
+	LandingAltitude: 1,
+	CruiseFloor:     1,
+}
+
+var (
+	ErrMaxDistanceTooShort = errors.New("max distance is too short to reach the next plot")
+	ErrDistanceOverflow    = errors.New("distance is too large to be calculated")
+	ErrPlanTooLarge        = errors.New("plan has too many waypoints or legs")
+	ErrNoLandingZone       = errors.New("no landing zone is reachable")
+)
+
 type Stats struct {
-	Estate              repository.Estate
-	Trees               Trees
-	Rest                Rest
-	Distance            int
-	CurrentHeight       int
-	CountFirstRest      bool
-	IsFirstRestResolved bool
-	MaxDistance         int
+	Estate          repository.Estate
+	Trees           Trees
+	Traversal       Traversal
+	Rest            Rest
+	Legs            []Leg
+	Distance        int
+	TotalDistance   int
+	Flight          Flight
+	currentHeight   int
+	CountRests      bool
+	MaxDistance     int
+	RecordWaypoints bool
+	Waypoints       []Waypoint
+	Performance     *Performance
+	Section         *Section
+	LandingZones    []Rest
+	Areas           []repository.RestrictedArea
+	Elevation       repository.Elevation
+	FlightProfile   FlightProfile
+	MaxDip          int
+
+	index           treeIndex
+	areas           []area
+	plotSize        int
+	profile         repository.DroneProfile
+	zones           zoneIndex
+	zoned           bool
+	transitAltitude int
+	leg             Leg
+	flying          bool
+	fresh           bool
+	started         bool
 }
 
 type Trees []repository.Tree
@@ -23,6 +74,36 @@ type Rest struct {
 	Y int
 }
 
+// Leg is the part of the mission flown on a single battery charge, from a
+// takeoff at Start until the landing at End. Distance includes the
+// Takeoff from the ground to the first altitude and the Landing from the
+// last altitude to the ground.
+type Leg struct {
+	Start    Rest
+	End      Rest
+	Distance int
+	Takeoff  int
+	Landing  int
+	Flight   Flight
+}
+
+// Waypoint is a point of the drone route, Distance is the cumulative
+// distance flown when the drone reaches it. The altitude is measured from
+// the reference level of the elevation grid, Ground is the elevation of
+// the plot under the drone.
+type Waypoint struct {
+	X        int
+	Y        int
+	Altitude int
+	Ground   int
+	Distance int
+}
+
+// RelativeAltitude returns the altitude of the waypoint above the ground.
+func (w Waypoint) RelativeAltitude() int {
+	return w.Altitude - w.Ground
+}
+
 func (t *Trees) GetTreeByCoordinate(x, y int) repository.Tree {
 	for _, tree := range *t {
 		if tree.X == x && tree.Y == y {
@@ -33,40 +114,434 @@ func (t *Trees) GetTreeByCoordinate(x, y int) repository.Tree {
 	return repository.Tree{}
 }
 
-func (s *Stats) CalculateDistance(x, y int) {
-	tree := s.Trees.GetTreeByCoordinate(x, y)
+func (s *Stats) CalculateDistance(x, y int) error {
+	return s.flyLevel(Run{X: x, Y: y, Count: 1}, s.ground(x, y)+s.altitude(s.height(x, y)))
+}
+
+// EstateDroneProfile returns the drone profile of an estate,
+// DefaultDroneProfile when it is not set.
+func EstateDroneProfile(estate repository.Estate) repository.DroneProfile {
+	if estate.DroneProfile == (repository.DroneProfile{}) {
+		return DefaultDroneProfile
+	}
+
+	return estate.DroneProfile
+}
+
+func (s *Stats) CalculateTotalDistance(ctx context.Context) error {
+	s.prepare()
+	s.Legs = nil
+	s.Waypoints = nil
+	s.flying = false
+	s.started = false
+	s.Distance = 0
+	s.TotalDistance = 0
+	s.Flight = Flight{}
+	s.currentHeight = 0
+	if s.zoned && s.zones.empty() {
+		return ErrNoLandingZone
+	}
+
+	section := s.section()
+	position := 0
+	err := s.Traversal.Walk(s.Estate.Length, s.Estate.Width, func(run Run) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		from, to := max(section.From-position, 0), min(section.To-position, run.Count)
+		position += run.Count
+		if from >= to {
+			if position >= section.To {
+				return errSectionEnd
+			}
+			return nil
+		}
+		run = run.skip(from, to-from)
+
+		return s.flyRun(run)
+	})
+	if err != nil && err != errSectionEnd {
+		return err
+	}
+	if !s.started {
+		// a mission without plots to fly over is only a takeoff and a
+		// landing, when there is somewhere to take off from
+		if s.noFly(1, 1) || !InsideEstate(s.Estate, 1, 1) {
+			return ErrNoRoute
+		}
+		if err := s.start(1, 1); err != nil {
+			return err
+		}
+	}
+
+	// land on the ground of the last plot, back home or on a landing zone
+	if err := s.returnHome(false); err != nil {
+		return err
+	}
+	s.Rest = s.Legs[0].End
+
+	return nil
+}
+
+// start takes off from the ground of the first plot of the mission, or from
+// home or the nearest reachable landing zone and flies to the first plot.
+func (s *Stats) start(x, y int) error {
+	s.fresh = true
+	if s.zones.empty() {
+		s.leg = Leg{Start: Rest{X: x, Y: y}, End: Rest{X: x, Y: y}}
+		s.currentHeight = s.ground(x, y)
+		s.addWaypoint(x, y)
+
+		return s.takeoff(s.currentHeight+s.profile.TakeoffAltitude, false)
+	}
+
+	zone, distance := s.nearestZone(Rest{X: x, Y: y})
+	if distance < 0 {
+		return ErrNoLandingZone
+	}
+	s.leg = Leg{Start: zone, End: zone}
+	s.currentHeight = s.ground(zone.X, zone.Y)
+	s.addWaypoint(zone.X, zone.Y)
+	if err := s.takeoff(s.transitAltitude, false); err != nil {
+		return err
+	}
+
+	return s.transit(Rest{X: x, Y: y}, false)
+}
 
-	s.Distance += int(math.Abs(float64(s.CurrentHeight) - float64(tree.Height+1)))
-	s.CurrentHeight = tree.Height + 1
+// prepare sets the defaults and indexes the trees before a plan.
+func (s *Stats) prepare() {
+	if s.Traversal == nil {
+		s.Traversal = Traversals[0]
+	}
+
+	s.index = newTreeIndex(s.Trees)
+	s.areas = newAreas(s.Areas)
+	s.plotSize = EstatePlotSize(s.Estate)
+	s.profile = EstateDroneProfile(s.Estate)
+	s.zones, s.zoned = s.landingZones()
+
+	tallest := 0
+	for _, tree := range s.Trees {
+		tallest = max(tallest, tree.Height)
+	}
+	for _, a := range s.areas {
+		tallest = max(tallest, a.height)
+	}
+	_, highest := ElevationRange(s.Elevation)
+	s.transitAltitude = highest + s.altitude(tallest)
+}
+
+// CalculateBestDistance plans the mission with every traversal and keeps the
+// one with the lowest total distance.
+func (s *Stats) CalculateBestDistance(ctx context.Context) error {
+	var best *Stats
+	var err error
+	for _, traversal := range Traversals {
+		candidate := *s
+		candidate.Traversal = traversal
+		if err = candidate.CalculateTotalDistance(ctx); err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			continue
+		}
 
-	if s.CountFirstRest && !s.IsFirstRestResolved {
-		if s.Distance+s.CurrentHeight <= s.MaxDistance {
-			s.Rest.X = x
-			s.Rest.Y = y
-		} else {
-			s.IsFirstRestResolved = true
+		if best == nil || candidate.TotalDistance < best.TotalDistance {
+			best = &candidate
 		}
 	}
 
-	if x < s.Estate.Length || y < s.Estate.Width {
-		s.Distance += 10
+	if best == nil {
+		return err
 	}
 
+	*s = *best
+	return nil
 }
 
-func (s *Stats) CalculateTotalDistance() {
-	s.CurrentHeight = 1
-	s.Distance = 1
-	for width := 1; width <= s.Estate.Width; width++ {
-		if width%2 == 1 {
-			for length := 1; length <= s.Estate.Length; length++ {
-				s.CalculateDistance(length, width)
+// flyRun flies over every plot of a run, the empty stretches between the
+// trees are flown at once. The mission starts at the first plot flown over,
+// and the drone flies over the plots outside the estate and around the
+// no-fly plots to the next stretch.
+func (s *Stats) flyRun(run Run) error {
+	return s.profileLevels(run, func(run Run, altitude int) error {
+		if run.Count == 0 {
+			return nil
+		}
+
+		next := Rest{X: run.X, Y: run.Y}
+		if !s.started {
+			s.started = true
+			if err := s.start(next.X, next.Y); err != nil {
+				return err
 			}
-		} else {
-			for length := s.Estate.Length; length >= 1; length-- {
-				s.CalculateDistance(length, width)
+		} else if s.flying && abs(next.X-s.leg.End.X)+abs(next.Y-s.leg.End.Y) != 1 {
+			if err := s.detour(next); err != nil {
+				return err
 			}
 		}
+
+		return s.flyLevel(run, altitude)
+	})
+}
+
+// levels splits a run into the stretches of plots flown at the same
+// altitude, some of them may be empty. The no-fly plots and the plots
+// outside the estate are left out.
+func (s *Stats) levels(run Run, visit func(run Run, altitude int) error) error {
+	if spans := s.spans(run); len(spans) > 0 || s.Elevation != nil {
+		return s.levelsAcross(run, spans, visit)
 	}
-	s.Distance += 1
+
+	visited := 0
+	for _, tree := range s.index.along(run) {
+		offset := run.offset(tree.X, tree.Y)
+		if err := visit(run.skip(visited, offset-visited), s.altitude(0)); err != nil {
+			return err
+		}
+
+		if err := visit(run.skip(offset, 1), s.altitude(tree.Height)); err != nil {
+			return err
+		}
+		visited = offset + 1
+	}
+
+	return visit(run.skip(visited, run.Count-visited), s.altitude(0))
+}
+
+// altitude returns the cruise altitude above the ground of a plot with a
+// tree of the given height, 0 for an empty plot.
+func (s *Stats) altitude(height int) int {
+	return max(height+s.profile.Clearance, s.profile.CruiseFloor)
+}
+
+// skip returns the part of the run with count plots starting after the
+// first n plots.
+func (r Run) skip(n, count int) Run {
+	return Run{X: r.X + r.DX*n, Y: r.Y + r.DY*n, DX: r.DX, DY: r.DY, Count: count}
+}
+
+// flyLevel flies over every plot of a run at the same height. Without rests
+// or waypoints the whole run is flown in one step, otherwise it is split at
+// every rest and every recorded plot.
+func (s *Stats) flyLevel(run Run, height int) error {
+	for run.Count > 0 {
+		hop := 0
+		if s.flying {
+			// fly from the center of the previous plot
+			hop = s.plotSize
+		}
+		climb := abs(s.currentHeight - height)
+
+		count := run.Count
+		if s.RecordWaypoints {
+			if len(s.Waypoints) >= MaxPlanSize {
+				return ErrPlanTooLarge
+			}
+			count = 1
+		}
+
+		// the drone must still be able to land after reaching the last
+		// plot, otherwise it rests and takes off again. The distance to
+		// land never decreases along a run, so the farthest plot is found
+		// with a binary search.
+		if s.CountRests {
+			budget := s.MaxDistance - s.leg.Distance - hop - climb
+			reach := sort.Search(count, func(i int) bool {
+				plot := run.skip(i, 1)
+				return s.plotSize*i+s.homing(plot.X, plot.Y, height) > budget
+			})
+			if reach == 0 {
+				if s.fresh && s.zoned {
+					return ErrNoLandingZone
+				}
+				if s.fresh {
+					return ErrMaxDistanceTooShort
+				}
+
+				if err := s.rest(Rest{X: run.X, Y: run.Y}); err != nil {
+					return err
+				}
+				continue
+			}
+
+			count = reach
+		}
+
+		if count > (math.MaxInt-hop-climb)/s.plotSize+1 {
+			return ErrDistanceOverflow
+		}
+
+		// the drone climbs above the previous plot before flying to the
+		// next one, and descends once above it
+		vertical := height - s.currentHeight
+		if hop > 0 && vertical > 0 {
+			if err := s.fly(0, vertical, false); err != nil {
+				return err
+			}
+			s.currentHeight = height
+			s.addWaypoint(s.leg.End.X, s.leg.End.Y)
+			vertical = 0
+		}
+		if hop > 0 && vertical < 0 {
+			if err := s.fly(hop, 0, false); err != nil {
+				return err
+			}
+			s.addWaypoint(run.X, run.Y)
+			hop = 0
+		}
+		if err := s.fly(hop+s.plotSize*(count-1), vertical, false); err != nil {
+			return err
+		}
+
+		last := run.skip(count-1, 1)
+		s.currentHeight = height
+		s.leg.End = Rest{X: last.X, Y: last.Y}
+		s.flying = true
+		s.fresh = false
+		s.addWaypoint(last.X, last.Y)
+		run = run.skip(count, run.Count-count)
+	}
+
+	return nil
+}
+
+// rest lands the drone on its current plot and takes off again to the same
+// altitude, starting a new leg. With a home or landing zones, the drone
+// flies to the nearest of them to rest and then straight to the next plot.
+func (s *Stats) rest(next Rest) error {
+	height := s.currentHeight
+	if err := s.returnHome(true); err != nil {
+		return err
+	}
+
+	s.leg = Leg{Start: s.leg.End, End: s.leg.End}
+	s.fresh = true
+	if s.zones.empty() {
+		return s.takeoff(height, true)
+	}
+
+	if err := s.takeoff(s.transitAltitude, true); err != nil {
+		return err
+	}
+	if err := s.transit(next, true); err != nil {
+		return err
+	}
+	s.flying = false
+
+	return nil
+}
+
+// takeoff climbs from the ground to the takeoff altitude and then to height,
+// starting the current leg.
+func (s *Stats) takeoff(height int, rest bool) error {
+	altitude := s.ground(s.leg.Start.X, s.leg.Start.Y) + s.profile.TakeoffAltitude
+	if err := s.fly(0, s.profile.TakeoffAltitude, rest); err != nil {
+		return err
+	}
+	s.currentHeight = altitude
+	if altitude > height {
+		s.addWaypoint(s.leg.Start.X, s.leg.Start.Y)
+	}
+
+	if err := s.fly(0, height-altitude, rest); err != nil {
+		return err
+	}
+	s.leg.Takeoff = s.profile.TakeoffAltitude + abs(altitude-height)
+	s.currentHeight = height
+	s.addWaypoint(s.leg.Start.X, s.leg.Start.Y)
+
+	return nil
+}
+
+// land flies to the landing altitude and descends to the ground, closing
+// the current leg.
+func (s *Stats) land(rest bool) error {
+	if len(s.Legs) >= MaxPlanSize {
+		return ErrPlanTooLarge
+	}
+
+	height := s.currentHeight
+	ground := s.ground(s.leg.End.X, s.leg.End.Y)
+	altitude := ground + s.profile.LandingAltitude
+	if err := s.fly(0, altitude-height, rest); err != nil {
+		return err
+	}
+	if altitude > height {
+		s.currentHeight = altitude
+		s.addWaypoint(s.leg.End.X, s.leg.End.Y)
+	}
+
+	if err := s.fly(0, -s.profile.LandingAltitude, rest); err != nil {
+		return err
+	}
+	s.leg.Landing = s.landing(s.leg.End, height)
+	s.currentHeight = ground
+	s.Legs = append(s.Legs, s.leg)
+	s.addWaypoint(s.leg.End.X, s.leg.End.Y)
+
+	return nil
+}
+
+// landing returns the distance to land on a plot from height.
+func (s *Stats) landing(at Rest, height int) int {
+	return abs(height-s.ground(at.X, at.Y)-s.profile.LandingAltitude) + s.profile.LandingAltitude
+}
+
+// fly adds a level distance and a climb, or a descent when negative, to the
+// current leg and the mission. The landing and takeoff of a rest, and the
+// flights to and from home for it, only count toward the total distance.
+func (s *Stats) fly(horizontal, vertical int, rest bool) error {
+	distance := horizontal + abs(vertical)
+	if distance < 0 || s.TotalDistance > math.MaxInt-distance {
+		return ErrDistanceOverflow
+	}
+
+	flight := Flight{Horizontal: horizontal}
+	if vertical > 0 {
+		flight.Climb = vertical
+	} else {
+		flight.Descent = -vertical
+	}
+	s.Flight = s.Flight.add(flight)
+	s.leg.Flight = s.leg.Flight.add(flight)
+
+	if !rest {
+		s.Distance += distance
+	}
+	s.TotalDistance += distance
+	s.leg.Distance += distance
+
+	return nil
+}
+
+func (s *Stats) addWaypoint(x, y int) {
+	if !s.RecordWaypoints {
+		return
+	}
+
+	if n := len(s.Waypoints); n > 0 {
+		last := s.Waypoints[n-1]
+		if last.X == x && last.Y == y && last.Altitude == s.currentHeight {
+			return
+		}
+	}
+
+	s.Waypoints = append(s.Waypoints, Waypoint{
+		X:        x,
+		Y:        y,
+		Altitude: s.currentHeight,
+		Ground:   s.ground(x, y),
+		Distance: s.TotalDistance,
+	})
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
 }
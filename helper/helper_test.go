@@ -1,6 +1,8 @@
 package helper
 
 import (
+	"context"
+	"math"
 	"testing"
 
 	"github.com/SawitProRecruitment/UserService/repository"
@@ -41,17 +43,190 @@ func Test_CalculateTotalDistance(t *testing.T) {
 		}
 
 		stats := Stats{
-			Estate:         estate,
-			Trees:          trees,
-			CountFirstRest: true,
-			MaxDistance:    100,
+			Estate:      estate,
+			Trees:       trees,
+			CountRests:  true,
+			MaxDistance: 100,
 		}
 
-		stats.CalculateTotalDistance()
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
 
 		assert.Equal(t, 204, stats.Distance)
 		assert.Equal(t, 2, stats.Rest.X)
 		assert.Equal(t, 2, stats.Rest.Y)
 	})
 
+	t.Run("calculate total distance with drone profile", func(t *testing.T) {
+		estate := repository.Estate{
+			ID:     "estate-123",
+			Length: 3,
+			Width:  1,
+			DroneProfile: repository.DroneProfile{
+				Clearance:       2,
+				TakeoffAltitude: 10,
+				LandingAltitude: 8,
+				CruiseFloor:     3,
+			},
+		}
+
+		stats := Stats{
+			Estate:          estate,
+			Trees:           Trees{repository.Tree{X: 2, Y: 1, Height: 5}},
+			RecordWaypoints: true,
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+		assert.Equal(t, 58, stats.Distance)
+		assert.Equal(t, []Leg{
+			{Start: Rest{X: 1, Y: 1}, End: Rest{X: 3, Y: 1}, Distance: 58, Takeoff: 10, Landing: 13,
+				Flight: Flight{Horizontal: 20, Climb: 19, Descent: 19}},
+		}, stats.Legs)
+		assert.Equal(t, []Waypoint{
+			{X: 1, Y: 1, Altitude: 0, Distance: 0},
+			{X: 1, Y: 1, Altitude: 10, Distance: 10},
+			{X: 1, Y: 1, Altitude: 3, Distance: 17},
+			{X: 1, Y: 1, Altitude: 7, Distance: 21},
+			{X: 2, Y: 1, Altitude: 7, Distance: 31},
+			{X: 3, Y: 1, Altitude: 7, Distance: 41},
+			{X: 3, Y: 1, Altitude: 3, Distance: 45},
+			{X: 3, Y: 1, Altitude: 8, Distance: 50},
+			{X: 3, Y: 1, Altitude: 0, Distance: 58},
+		}, stats.Waypoints)
+	})
+
+	t.Run("calculate total distance with waypoints", func(t *testing.T) {
+		estate := repository.Estate{
+			ID:     "estate-123",
+			Length: 3,
+			Width:  2,
+		}
+
+		stats := Stats{
+			Estate: estate,
+			Trees: Trees{
+				repository.Tree{X: 2, Y: 1, Height: 5},
+				repository.Tree{X: 3, Y: 2, Height: 2},
+			},
+			RecordWaypoints: true,
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+		assert.Equal(t, 66, stats.Distance)
+		assert.Equal(t, []Waypoint{
+			{X: 1, Y: 1, Altitude: 0, Distance: 0},
+			{X: 1, Y: 1, Altitude: 1, Distance: 1},
+			{X: 1, Y: 1, Altitude: 6, Distance: 6},
+			{X: 2, Y: 1, Altitude: 6, Distance: 16},
+			{X: 3, Y: 1, Altitude: 6, Distance: 26},
+			{X: 3, Y: 1, Altitude: 1, Distance: 31},
+			{X: 3, Y: 1, Altitude: 3, Distance: 33},
+			{X: 3, Y: 2, Altitude: 3, Distance: 43},
+			{X: 2, Y: 2, Altitude: 3, Distance: 53},
+			{X: 2, Y: 2, Altitude: 1, Distance: 55},
+			{X: 1, Y: 2, Altitude: 1, Distance: 65},
+			{X: 1, Y: 2, Altitude: 0, Distance: 66},
+		}, stats.Waypoints)
+	})
+
+	t.Run("calculate total distance with multiple legs", func(t *testing.T) {
+		stats := Stats{
+			Estate:      repository.Estate{Length: 5, Width: 1},
+			CountRests:  true,
+			MaxDistance: 25,
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+		assert.Equal(t, 42, stats.Distance)
+		assert.Equal(t, 44, stats.TotalDistance)
+		assert.Equal(t, Rest{X: 3, Y: 1}, stats.Rest)
+		assert.Equal(t, []Leg{
+			{Start: Rest{X: 1, Y: 1}, End: Rest{X: 3, Y: 1}, Distance: 22, Takeoff: 1, Landing: 1,
+				Flight: Flight{Horizontal: 20, Climb: 1, Descent: 1}},
+			{Start: Rest{X: 3, Y: 1}, End: Rest{X: 5, Y: 1}, Distance: 22, Takeoff: 1, Landing: 1,
+				Flight: Flight{Horizontal: 20, Climb: 1, Descent: 1}},
+		}, stats.Legs)
+		assert.Equal(t, Flight{Horizontal: 40, Climb: 2, Descent: 2}, stats.Flight)
+	})
+
+	t.Run("failed to calculate total distance: max distance too short", func(t *testing.T) {
+		stats := Stats{
+			Estate:      repository.Estate{Length: 5, Width: 1},
+			CountRests:  true,
+			MaxDistance: 5,
+		}
+
+		assert.ErrorIs(t, stats.CalculateTotalDistance(context.Background()), ErrMaxDistanceTooShort)
+	})
+
+	t.Run("calculate total distance of a very large estate", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 50000, Width: 50000},
+			Trees: Trees{
+				repository.Tree{X: 1, Y: 2, Height: 10},
+				repository.Tree{X: 50000, Y: 50000, Height: 5},
+			},
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+		assert.Equal(t, 1+20+10*(50000*50000-1)+10+1, stats.Distance)
+	})
+
+	t.Run("failed to calculate total distance: context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		stats := Stats{Estate: repository.Estate{Length: 10, Width: 10}}
+
+		assert.ErrorIs(t, stats.CalculateTotalDistance(ctx), context.Canceled)
+	})
+
+	t.Run("failed to calculate total distance: distance overflow", func(t *testing.T) {
+		stats := Stats{Estate: repository.Estate{Length: math.MaxInt / 5, Width: 1}}
+
+		assert.ErrorIs(t, stats.CalculateTotalDistance(context.Background()), ErrDistanceOverflow)
+	})
+
+	t.Run("failed to calculate total distance: too many waypoints", func(t *testing.T) {
+		stats := Stats{
+			Estate:          repository.Estate{Length: 50000, Width: 50000},
+			RecordWaypoints: true,
+		}
+
+		assert.ErrorIs(t, stats.CalculateTotalDistance(context.Background()), ErrPlanTooLarge)
+	})
+
+	t.Run("empty stretches match the plot by plot flight", func(t *testing.T) {
+		trees := Trees{
+			repository.Tree{X: 2, Y: 1, Height: 5},
+			repository.Tree{X: 7, Y: 1, Height: 12},
+			repository.Tree{X: 3, Y: 4, Height: 15},
+			repository.Tree{X: 9, Y: 6, Height: 1},
+			repository.Tree{X: 1, Y: 7, Height: 8},
+		}
+
+		for _, traversal := range Traversals {
+			for _, maxDistance := range []int{0, 60, 100, 250} {
+				stats := Stats{
+					Estate:      repository.Estate{Length: 9, Width: 7},
+					Trees:       trees,
+					Traversal:   traversal,
+					CountRests:  maxDistance > 0,
+					MaxDistance: maxDistance,
+				}
+				plotByPlot := stats
+				plotByPlot.RecordWaypoints = true
+
+				assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+				assert.NoError(t, plotByPlot.CalculateTotalDistance(context.Background()))
+				assert.Equal(t, plotByPlot.Distance, stats.Distance)
+				assert.Equal(t, plotByPlot.TotalDistance, stats.TotalDistance)
+				assert.Equal(t, plotByPlot.Legs, stats.Legs)
+				assert.Equal(t, plotByPlot.TotalDistance, plotByPlot.Waypoints[len(plotByPlot.Waypoints)-1].Distance)
+			}
+		}
+	})
+
 }
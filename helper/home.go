@@ -0,0 +1,155 @@
+package helper
+
+import (
+	"math"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+)
+
+// EstateHome returns the plot where the drone of an estate takes off and
+// lands, it may be outside of the estate. It reports false when the estate
+// has no home and the drone takes off and lands on the plots it flies over.
+func EstateHome(estate repository.Estate) (Rest, bool) {
+	if estate.HomeX == nil || estate.HomeY == nil {
+		return Rest{}, false
+	}
+
+	return Rest{X: *estate.HomeX, Y: *estate.HomeY}, true
+}
+
+// landingZones returns the plots where the drone lands to rest: the home
+// of the estate when it has one, otherwise its landing zones without a
+// tree, an obstacle or a no-fly area and within the boundary. It reports
+// whether the drone must land on a landing zone.
+func (s *Stats) landingZones() (zoneIndex, bool) {
+	if home, ok := EstateHome(s.Estate); ok {
+		return newZoneIndex([]Rest{home}), false
+	}
+
+	zones := make([]Rest, 0, len(s.LandingZones))
+	for _, zone := range s.LandingZones {
+		if s.height(zone.X, zone.Y) == 0 && !s.noFly(zone.X, zone.Y) && InsideEstate(s.Estate, zone.X, zone.Y) {
+			zones = append(zones, zone)
+		}
+	}
+
+	return newZoneIndex(zones), len(s.LandingZones) > 0
+}
+
+// nearestZone returns the landing zone with the shortest route around the
+// no-fly areas from a plot, and the number of plots along the route, -1
+// when no landing zone can be reached. The index must not be empty.
+func (s *Stats) nearestZone(plot Rest) (Rest, int) {
+	zone := s.zones.nearest(plot.X, plot.Y)
+	distance := s.routeLength(plot, zone)
+	if distance == abs(zone.X-plot.X)+abs(zone.Y-plot.Y) {
+		return zone, distance
+	}
+
+	// the nearest zone is behind a no-fly area, the others are tried until
+	// their distance along the length and the width, which no route is
+	// shorter than, is past the shortest route found
+	for _, candidate := range s.zones.byDistance(plot.X, plot.Y) {
+		if distance >= 0 && abs(candidate.X-plot.X)+abs(candidate.Y-plot.Y) >= distance {
+			break
+		}
+		if d := s.routeLength(plot, candidate); d >= 0 && (distance < 0 || d < distance) {
+			zone, distance = candidate, d
+		}
+	}
+
+	return zone, distance
+}
+
+// returnHome flies back home or to the nearest landing zone, when the
+// estate has them, and lands.
+func (s *Stats) returnHome(rest bool) error {
+	if !s.zones.empty() {
+		zone, distance := s.nearestZone(s.leg.End)
+		if distance < 0 {
+			return ErrNoLandingZone
+		}
+		if err := s.transit(zone, rest); err != nil {
+			return err
+		}
+	}
+
+	return s.land(rest)
+}
+
+// transit flies from the current plot to another one at the transit
+// altitude, clear of every tree and obstacle of the estate, along the
+// length and then along the width unless it must fly around a no-fly area.
+func (s *Stats) transit(to Rest, rest bool) error {
+	from := s.leg.End
+	turns, ok := s.route(from, to)
+	if !ok {
+		return ErrNoRoute
+	}
+
+	if err := s.fly(0, s.transitAltitude-s.currentHeight, rest); err != nil {
+		return err
+	}
+	s.currentHeight = s.transitAltitude
+	s.addWaypoint(from.X, from.Y)
+
+	for _, turn := range turns {
+		distance := abs(turn.X-from.X) + abs(turn.Y-from.Y)
+		if distance > math.MaxInt/s.plotSize {
+			return ErrDistanceOverflow
+		}
+		if err := s.fly(s.plotSize*distance, 0, rest); err != nil {
+			return err
+		}
+		s.addWaypoint(turn.X, turn.Y)
+		from = turn
+	}
+	s.leg.End = to
+
+	return nil
+}
+
+// detour flies from the last plot flown over to the next one, not adjacent
+// to it, resting first when the drone could not land after it.
+func (s *Stats) detour(next Rest) error {
+	if s.CountRests && !s.fresh {
+		distance := s.routeLength(s.leg.End, next)
+		if distance < 0 {
+			return ErrNoRoute
+		}
+
+		cost := abs(s.transitAltitude-s.currentHeight) + s.plotSize*min(distance, math.MaxInt/2/s.plotSize)
+		if cost > s.MaxDistance-s.leg.Distance-s.homing(next.X, next.Y, s.transitAltitude) {
+			if err := s.rest(next); err != nil {
+				return err
+			}
+			if !s.zones.empty() {
+				// the drone already flew from the rest to the next plot
+				return nil
+			}
+		}
+	}
+
+	if err := s.transit(next, false); err != nil {
+		return err
+	}
+	s.flying = false
+
+	return nil
+}
+
+// homing returns the distance to land once the drone reached plot (x, y) at
+// height, flying back home or to the nearest landing zone first when the
+// estate has them.
+func (s *Stats) homing(x, y, height int) int {
+	if s.zones.empty() {
+		return s.landing(Rest{X: x, Y: y}, height)
+	}
+
+	zone, distance := s.nearestZone(Rest{X: x, Y: y})
+	if distance < 0 || distance > (math.MaxInt/2)/s.plotSize {
+		return math.MaxInt / 2
+	}
+
+	return abs(height-s.transitAltitude) + s.plotSize*distance + s.landing(zone, s.transitAltitude)
+}
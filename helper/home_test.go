@@ -0,0 +1,171 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CalculateTotalDistanceFromHome(t *testing.T) {
+	homeX, homeY := 0, 1
+
+	t.Run("fly from home and return home", func(t *testing.T) {
+		stats := Stats{
+			Estate:          repository.Estate{Length: 3, Width: 1, HomeX: &homeX, HomeY: &homeY},
+			Trees:           Trees{repository.Tree{X: 2, Y: 1, Height: 4}},
+			RecordWaypoints: true,
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+		// 5 up to the transit altitude above the tallest tree, 10 to the
+		// estate, 4 down to the first plot, 2 hops over the tree, 4 back up
+		// and 30 home before landing from 5
+		assert.Equal(t, 5+10+4+(10+4)+(10+4)+4+30+5, stats.Distance)
+		assert.Equal(t, []Waypoint{
+			{X: 0, Y: 1, Altitude: 0, Distance: 0},
+			{X: 0, Y: 1, Altitude: 5, Distance: 5},
+			{X: 1, Y: 1, Altitude: 5, Distance: 15},
+			{X: 1, Y: 1, Altitude: 1, Distance: 19},
+			{X: 1, Y: 1, Altitude: 5, Distance: 23},
+			{X: 2, Y: 1, Altitude: 5, Distance: 33},
+			{X: 3, Y: 1, Altitude: 5, Distance: 43},
+			{X: 3, Y: 1, Altitude: 1, Distance: 47},
+			{X: 3, Y: 1, Altitude: 5, Distance: 51},
+			{X: 0, Y: 1, Altitude: 5, Distance: 81},
+			{X: 0, Y: 1, Altitude: 0, Distance: 86},
+		}, stats.Waypoints)
+		assert.Equal(t, Rest{X: 0, Y: 1}, stats.Legs[0].Start)
+		assert.Equal(t, Rest{X: 0, Y: 1}, stats.Legs[0].End)
+	})
+
+	t.Run("rest at home", func(t *testing.T) {
+		stats := Stats{
+			Estate:      repository.Estate{Length: 6, Width: 3, HomeX: &homeX, HomeY: &homeY},
+			CountRests:  true,
+			MaxDistance: 170,
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+		assert.Greater(t, len(stats.Legs), 1)
+		for _, leg := range stats.Legs {
+			assert.LessOrEqual(t, leg.Distance, 170)
+			assert.Equal(t, Rest{X: 0, Y: 1}, leg.Start)
+			assert.Equal(t, Rest{X: 0, Y: 1}, leg.End)
+		}
+		assert.Equal(t, Rest{X: 0, Y: 1}, stats.Rest)
+	})
+
+	t.Run("failed to calculate total distance: home too far", func(t *testing.T) {
+		farX := -10
+		stats := Stats{
+			Estate:      repository.Estate{Length: 2, Width: 1, HomeX: &farX, HomeY: &homeY},
+			CountRests:  true,
+			MaxDistance: 200,
+		}
+
+		assert.ErrorIs(t, stats.CalculateTotalDistance(context.Background()), ErrMaxDistanceTooShort)
+	})
+}
+
+func Test_CalculateTotalDistanceWithLandingZones(t *testing.T) {
+	t.Run("rest on the nearest landing zone", func(t *testing.T) {
+		stats := Stats{
+			Estate:       repository.Estate{Length: 9, Width: 1},
+			Trees:        Trees{repository.Tree{X: 5, Y: 1, Height: 20}},
+			LandingZones: []Rest{{X: 2, Y: 1}, {X: 5, Y: 1}, {X: 8, Y: 1}},
+			CountRests:   true,
+			MaxDistance:  150,
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+		assert.Greater(t, len(stats.Legs), 1)
+		for _, leg := range stats.Legs {
+			assert.LessOrEqual(t, leg.Distance, 150)
+			assert.Contains(t, []Rest{{X: 2, Y: 1}, {X: 8, Y: 1}}, leg.Start)
+			assert.Contains(t, []Rest{{X: 2, Y: 1}, {X: 8, Y: 1}}, leg.End)
+		}
+	})
+
+	t.Run("no landing zone without a tree", func(t *testing.T) {
+		stats := Stats{
+			Estate:       repository.Estate{Length: 3, Width: 1},
+			Trees:        Trees{repository.Tree{X: 2, Y: 1, Height: 20}},
+			LandingZones: []Rest{{X: 2, Y: 1}},
+		}
+
+		assert.ErrorIs(t, stats.CalculateTotalDistance(context.Background()), ErrNoLandingZone)
+	})
+
+	t.Run("landing zone out of reach", func(t *testing.T) {
+		stats := Stats{
+			Estate:       repository.Estate{Length: 20, Width: 1},
+			LandingZones: []Rest{{X: 1, Y: 1}},
+			CountRests:   true,
+			MaxDistance:  100,
+		}
+
+		assert.ErrorIs(t, stats.CalculateTotalDistance(context.Background()), ErrNoLandingZone)
+	})
+}
+
+func Test_ZoneIndexNearest(t *testing.T) {
+	index := newZoneIndex([]Rest{{X: 1, Y: 1}, {X: 9, Y: 2}, {X: 4, Y: 7}, {X: 6, Y: 7}})
+
+	assert.Equal(t, Rest{X: 1, Y: 1}, index.nearest(1, 1))
+	assert.Equal(t, Rest{X: 9, Y: 2}, index.nearest(8, 4))
+	assert.Equal(t, Rest{X: 6, Y: 7}, index.nearest(6, 9))
+	assert.Equal(t, Rest{X: 4, Y: 7}, index.nearest(3, 5))
+}
+
+func Test_NearestZone(t *testing.T) {
+	t.Run("skip the nearest landing zone enclosed by no-fly areas", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 10, Width: 10},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.NoFlyArea, X: 1, Y: 1, Length: 3, Width: 1},
+				{Kind: repository.NoFlyArea, X: 1, Y: 3, Length: 3, Width: 1},
+				{Kind: repository.NoFlyArea, X: 1, Y: 2, Length: 1, Width: 1},
+				{Kind: repository.NoFlyArea, X: 3, Y: 2, Length: 1, Width: 1},
+			},
+			LandingZones: []Rest{{X: 2, Y: 2}, {X: 8, Y: 2}},
+		}
+		stats.prepare()
+
+		zone, distance := stats.nearestZone(Rest{X: 4, Y: 2})
+		assert.Equal(t, Rest{X: 8, Y: 2}, zone)
+		assert.Equal(t, 4, distance)
+	})
+
+	t.Run("prefer the shorter route over the nearer landing zone", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 10, Width: 10},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.NoFlyArea, X: 5, Y: 1, Length: 1, Width: 9},
+			},
+			LandingZones: []Rest{{X: 6, Y: 1}, {X: 1, Y: 5}},
+		}
+		stats.prepare()
+
+		zone, distance := stats.nearestZone(Rest{X: 4, Y: 1})
+		assert.Equal(t, Rest{X: 1, Y: 5}, zone)
+		assert.Equal(t, 7, distance)
+	})
+
+	t.Run("no reachable landing zone", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 10, Width: 10},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.NoFlyArea, X: 5, Y: 1, Length: 1, Width: 10},
+			},
+			LandingZones: []Rest{{X: 6, Y: 1}, {X: 9, Y: 9}},
+		}
+		stats.prepare()
+
+		_, distance := stats.nearestZone(Rest{X: 4, Y: 1})
+		assert.Equal(t, -1, distance)
+	})
+}
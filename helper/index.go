@@ -0,0 +1,162 @@
+package helper
+
+import (
+	"sort"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+)
+
+// treeIndex keeps the trees of each row and each column sorted, so the
+// trees along a run are found with a binary search instead of a scan.
+type treeIndex struct {
+	rows map[int][]repository.Tree
+	cols map[int][]repository.Tree
+}
+
+func newTreeIndex(trees Trees) treeIndex {
+	index := treeIndex{
+		rows: make(map[int][]repository.Tree),
+		cols: make(map[int][]repository.Tree),
+	}
+
+	for _, tree := range trees {
+		index.rows[tree.Y] = append(index.rows[tree.Y], tree)
+		index.cols[tree.X] = append(index.cols[tree.X], tree)
+	}
+
+	for _, row := range index.rows {
+		sort.Slice(row, func(i, j int) bool { return row[i].X < row[j].X })
+	}
+	for _, col := range index.cols {
+		sort.Slice(col, func(i, j int) bool { return col[i].Y < col[j].Y })
+	}
+
+	return index
+}
+
+// height returns the height of the tree on a plot, 0 when there is none.
+func (i treeIndex) height(x, y int) int {
+	row := i.rows[y]
+	n := sort.Search(len(row), func(j int) bool { return row[j].X >= x })
+	if n < len(row) && row[n].X == x {
+		return row[n].Height
+	}
+
+	return 0
+}
+
+// along returns the trees of a run in flight order.
+func (i treeIndex) along(run Run) []repository.Tree {
+	line, position := i.rows[run.Y], func(tree repository.Tree) int { return tree.X }
+	from, step := run.X, run.DX
+	if run.DX == 0 {
+		line, position = i.cols[run.X], func(tree repository.Tree) int { return tree.Y }
+		from, step = run.Y, run.DY
+	}
+
+	to := from + step*(run.Count-1)
+	if to < from {
+		from, to = to, from
+	}
+
+	lo := sort.Search(len(line), func(j int) bool { return position(line[j]) >= from })
+	hi := sort.Search(len(line), func(j int) bool { return position(line[j]) > to })
+
+	trees := make([]repository.Tree, 0, hi-lo)
+	if step >= 0 {
+		trees = append(trees, line[lo:hi]...)
+	} else {
+		for j := hi - 1; j >= lo; j-- {
+			trees = append(trees, line[j])
+		}
+	}
+
+	return trees
+}
+
+// zoneIndex keeps the landing zones of each row sorted, so the nearest zone
+// of a plot is found by searching the rows outward from the plot.
+type zoneIndex struct {
+	ys   []int
+	rows map[int][]int
+}
+
+func newZoneIndex(zones []Rest) zoneIndex {
+	index := zoneIndex{rows: make(map[int][]int)}
+	for _, zone := range zones {
+		if _, ok := index.rows[zone.Y]; !ok {
+			index.ys = append(index.ys, zone.Y)
+		}
+		index.rows[zone.Y] = append(index.rows[zone.Y], zone.X)
+	}
+
+	sort.Ints(index.ys)
+	for _, row := range index.rows {
+		sort.Ints(row)
+	}
+
+	return index
+}
+
+func (i zoneIndex) empty() bool {
+	return len(i.ys) == 0
+}
+
+// nearest returns the zone with the shortest flight along the length and
+// the width from plot (x, y). The index must not be empty.
+func (i zoneIndex) nearest(x, y int) Rest {
+	var best Rest
+	distance := -1
+	visit := func(row int) bool {
+		dy := abs(row - y)
+		if distance >= 0 && dy >= distance {
+			return false
+		}
+
+		xs := i.rows[row]
+		n := sort.SearchInts(xs, x)
+		for _, j := range []int{n - 1, n} {
+			if j < 0 || j >= len(xs) {
+				continue
+			}
+			if d := abs(xs[j]-x) + dy; distance < 0 || d < distance {
+				best, distance = Rest{X: xs[j], Y: row}, d
+			}
+		}
+
+		return true
+	}
+
+	n := sort.SearchInts(i.ys, y)
+	for lo, hi := n-1, n; lo >= 0 || hi < len(i.ys); {
+		if hi < len(i.ys) && !visit(i.ys[hi]) {
+			hi = len(i.ys)
+		} else {
+			hi++
+		}
+		if lo >= 0 && !visit(i.ys[lo]) {
+			lo = -1
+		} else {
+			lo--
+		}
+	}
+
+	return best
+}
+
+// byDistance returns every zone, the nearest ones to plot (x, y) along the
+// length and the width first.
+func (i zoneIndex) byDistance(x, y int) []Rest {
+	zones := make([]Rest, 0, len(i.ys))
+	for _, row := range i.ys {
+		for _, zx := range i.rows[row] {
+			zones = append(zones, Rest{X: zx, Y: row})
+		}
+	}
+
+	sort.SliceStable(zones, func(a, b int) bool {
+		return abs(zones[a].X-x)+abs(zones[a].Y-y) < abs(zones[b].X-x)+abs(zones[b].Y-y)
+	})
+
+	return zones
+}
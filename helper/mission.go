@@ -0,0 +1,170 @@
+package helper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MAVLink commands and frames used by the mission files.
+const (
+	MavCmdNavWaypoint = 16
+	MavCmdNavLand     = 21
+	MavCmdNavTakeoff  = 22
+
+	MavFrameGlobalRelativeAlt = 3
+)
+
+// ErrNotGeoReferenced is returned for the mission of an estate without a
+// real-world origin, the autopilots only fly to latitudes and longitudes.
+var ErrNotGeoReferenced = errors.New("estate is not geo-referenced")
+
+// MissionItem is a MAVLink mission command, X and Y are the latitude and
+// longitude. The altitude is relative to the home position, the ground of
+// the first plot.
+type MissionItem struct {
+	Command  int
+	Frame    int
+	X        float64
+	Y        float64
+	Altitude float64
+}
+
+// Mission converts the recorded waypoints into mission items. The first
+// item is the home position on the ground of the first plot, the drone
+// then takes off, flies over the center of every plot and lands at every
+// rest and at the end of the mission. It climbs above a plot before flying
+// to the next one and descends once above it, as the distances assume.
+// The estate must be geo-referenced.
+func (s *Stats) Mission() ([]MissionItem, error) {
+	if !IsGeoReferenced(s.Estate) {
+		return nil, ErrNotGeoReferenced
+	}
+
+	items := make([]MissionItem, 0, len(s.Waypoints))
+	for i, waypoint := range s.Waypoints {
+		location, _ := PlotLocation(s.Estate, waypoint.X, waypoint.Y)
+		item := MissionItem{
+			Command:  MavCmdNavWaypoint,
+			Frame:    MavFrameGlobalRelativeAlt,
+			X:        location.Latitude,
+			Y:        location.Longitude,
+			Altitude: float64(waypoint.Altitude - s.Waypoints[0].Altitude),
+		}
+
+		switch {
+		case i == 0:
+		case waypoint.RelativeAltitude() == 0:
+			item.Command = MavCmdNavLand
+		case s.Waypoints[i-1].RelativeAltitude() == 0:
+			item.Command = MavCmdNavTakeoff
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+type qgcPlan struct {
+	FileType      string        `json:"fileType"`
+	GeoFence      qgcGeoFence   `json:"geoFence"`
+	GroundStation string        `json:"groundStation"`
+	Mission       qgcMission    `json:"mission"`
+	RallyPoints   qgcRallyPoint `json:"rallyPoints"`
+	Version       int           `json:"version"`
+}
+
+type qgcGeoFence struct {
+	Circles  []any `json:"circles"`
+	Polygons []any `json:"polygons"`
+	Version  int   `json:"version"`
+}
+
+type qgcRallyPoint struct {
+	Points  []any `json:"points"`
+	Version int   `json:"version"`
+}
+
+type qgcMission struct {
+	CruiseSpeed         float64          `json:"cruiseSpeed"`
+	FirmwareType        int              `json:"firmwareType"`
+	HoverSpeed          float64          `json:"hoverSpeed"`
+	Items               []qgcMissionItem `json:"items"`
+	PlannedHomePosition [3]float64       `json:"plannedHomePosition"`
+	VehicleType         int              `json:"vehicleType"`
+	Version             int              `json:"version"`
+}
+
+type qgcMissionItem struct {
+	AutoContinue bool        `json:"autoContinue"`
+	Command      int         `json:"command"`
+	DoJumpID     int         `json:"doJumpId"`
+	Frame        int         `json:"frame"`
+	Params       [7]*float64 `json:"params"`
+	Type         string      `json:"type"`
+}
+
+// QGCPlan encodes mission items as a QGroundControl .plan file.
+func QGCPlan(items []MissionItem) ([]byte, error) {
+	plan := qgcPlan{
+		FileType:      "Plan",
+		GeoFence:      qgcGeoFence{Circles: []any{}, Polygons: []any{}, Version: 2},
+		GroundStation: "QGroundControl",
+		Mission: qgcMission{
+			CruiseSpeed:  15,
+			FirmwareType: 12,
+			HoverSpeed:   5,
+			Items:        make([]qgcMissionItem, 0, len(items)),
+			VehicleType:  2,
+			Version:      2,
+		},
+		RallyPoints: qgcRallyPoint{Points: []any{}, Version: 2},
+		Version:     1,
+	}
+
+	for i, item := range items {
+		if i == 0 {
+			plan.Mission.PlannedHomePosition = [3]float64{item.X, item.Y, item.Altitude}
+			continue
+		}
+
+		zero, x, y, altitude := 0.0, item.X, item.Y, item.Altitude
+		plan.Mission.Items = append(plan.Mission.Items, qgcMissionItem{
+			AutoContinue: true,
+			Command:      item.Command,
+			DoJumpID:     i,
+			Frame:        item.Frame,
+			// the yaw is left empty so the drone keeps its heading
+			Params: [7]*float64{&zero, &zero, &zero, nil, &x, &y, &altitude},
+			Type:   "SimpleItem",
+		})
+	}
+
+	return json.MarshalIndent(plan, "", "    ")
+}
+
+// MAVLinkWaypoints encodes mission items as a MAVLink .waypoints file, the
+// home position is the first line.
+func MAVLinkWaypoints(items []MissionItem) []byte {
+	var file strings.Builder
+	file.WriteString("QGC WPL 110\n")
+	for i, item := range items {
+		current := 0
+		if i == 0 {
+			current = 1
+		}
+
+		fmt.Fprintf(&file, "%d\t%d\t%d\t%d\t0\t0\t0\t0\t%s\t%s\t%s\t1\n",
+			i, current, item.Frame, item.Command,
+			formatFloat(item.X), formatFloat(item.Y), formatFloat(item.Altitude))
+	}
+
+	return []byte(file.String())
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
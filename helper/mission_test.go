@@ -0,0 +1,87 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Mission(t *testing.T) {
+	latitude, longitude := 1.0, 100.0
+	stats := Stats{
+		Estate: repository.Estate{
+			Length:          2,
+			Width:           1,
+			OriginLatitude:  &latitude,
+			OriginLongitude: &longitude,
+		},
+		Trees:           Trees{repository.Tree{X: 2, Y: 1, Height: 5}},
+		RecordWaypoints: true,
+	}
+	assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+	mission, err := stats.Mission()
+	assert.NoError(t, err)
+	first, _ := PlotLocation(stats.Estate, 1, 1)
+	second, _ := PlotLocation(stats.Estate, 2, 1)
+
+	t.Run("mission items", func(t *testing.T) {
+		assert.Equal(t, []MissionItem{
+			{Command: MavCmdNavWaypoint, Frame: MavFrameGlobalRelativeAlt, X: first.Latitude, Y: first.Longitude, Altitude: 0},
+			{Command: MavCmdNavTakeoff, Frame: MavFrameGlobalRelativeAlt, X: first.Latitude, Y: first.Longitude, Altitude: 1},
+			{Command: MavCmdNavWaypoint, Frame: MavFrameGlobalRelativeAlt, X: first.Latitude, Y: first.Longitude, Altitude: 6},
+			{Command: MavCmdNavWaypoint, Frame: MavFrameGlobalRelativeAlt, X: second.Latitude, Y: second.Longitude, Altitude: 6},
+			{Command: MavCmdNavLand, Frame: MavFrameGlobalRelativeAlt, X: second.Latitude, Y: second.Longitude, Altitude: 0},
+		}, mission)
+		assert.Greater(t, second.Longitude, first.Longitude)
+	})
+
+	t.Run("mavlink waypoints file", func(t *testing.T) {
+		firstPosition := formatFloat(first.Latitude) + "\t" + formatFloat(first.Longitude)
+		secondPosition := formatFloat(second.Latitude) + "\t" + formatFloat(second.Longitude)
+		assert.Equal(t, "QGC WPL 110\n"+
+			"0\t1\t3\t16\t0\t0\t0\t0\t"+firstPosition+"\t0\t1\n"+
+			"1\t0\t3\t22\t0\t0\t0\t0\t"+firstPosition+"\t1\t1\n"+
+			"2\t0\t3\t16\t0\t0\t0\t0\t"+firstPosition+"\t6\t1\n"+
+			"3\t0\t3\t16\t0\t0\t0\t0\t"+secondPosition+"\t6\t1\n"+
+			"4\t0\t3\t21\t0\t0\t0\t0\t"+secondPosition+"\t0\t1\n", string(MAVLinkWaypoints(mission)))
+	})
+
+	t.Run("qgroundcontrol plan file", func(t *testing.T) {
+		file, err := QGCPlan(mission)
+		assert.NoError(t, err)
+
+		var plan struct {
+			FileType string `json:"fileType"`
+			Mission  struct {
+				Items []struct {
+					Command int        `json:"command"`
+					Params  []*float64 `json:"params"`
+				} `json:"items"`
+				PlannedHomePosition []float64 `json:"plannedHomePosition"`
+			} `json:"mission"`
+		}
+		assert.NoError(t, json.Unmarshal(file, &plan))
+		assert.Equal(t, "Plan", plan.FileType)
+		assert.Equal(t, []float64{first.Latitude, first.Longitude, 0}, plan.Mission.PlannedHomePosition)
+		assert.Len(t, plan.Mission.Items, 4)
+		assert.Equal(t, MavCmdNavTakeoff, plan.Mission.Items[0].Command)
+		assert.Equal(t, 6.0, *plan.Mission.Items[1].Params[6])
+		assert.Nil(t, plan.Mission.Items[1].Params[3])
+		assert.Equal(t, MavCmdNavLand, plan.Mission.Items[3].Command)
+	})
+}
+
+func Test_MissionNotGeoReferenced(t *testing.T) {
+	stats := Stats{
+		Estate:          repository.Estate{Length: 2, Width: 1},
+		RecordWaypoints: true,
+	}
+	assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+	_, err := stats.Mission()
+	assert.ErrorIs(t, err, ErrNotGeoReferenced)
+}
@@ -0,0 +1,157 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"math"
+)
+
+// MaxFleetSize caps the number of drones sharing the survey of an estate.
+const MaxFleetSize = 100
+
+var ErrInvalidFleetSize = errors.New("fleet size must be between 1 and the number of plots flown over")
+
+// errSectionEnd stops a walk once the last plot of the section is flown.
+var errSectionEnd = errors.New("end of section")
+
+// Section is the part of a traversal from its plot at index From up to the
+// plot before index To, the plots being numbered from 0 in flight order.
+type Section struct {
+	From int
+	To   int
+}
+
+// Partition splits the traversal of the estate into n contiguous sections
+// of roughly equal flight distance, ignoring the takeoffs, landings and
+// rests.
+func (s *Stats) Partition(ctx context.Context, n int) ([]Section, error) {
+	if n < 1 || n > MaxFleetSize {
+		return nil, ErrInvalidFleetSize
+	}
+
+	s.prepare()
+
+	// stretches of plots flown over, with the distance flown from the first
+	// plot to reach them; cuts are only taken at plots flown over so that
+	// every section has one
+	type stretch struct{ index, count, distance int }
+	var stretches []stretch
+	var flown, total int
+	err := s.walkLevels(ctx, func(index, count, distance int) {
+		stretches = append(stretches, stretch{index: index, count: count, distance: distance})
+		flown += count
+		total = distance + s.plotSize*(count-1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if n > flown {
+		return nil, ErrInvalidFleetSize
+	}
+
+	// cuts are numbered among the plots flown over
+	cuts := make([]int, 0, n)
+	before := 0
+	for _, st := range stretches {
+		for len(cuts) < n-1 {
+			k := len(cuts) + 1
+			threshold := total/n*k + total%n*k/n
+			if threshold > st.distance+s.plotSize*(st.count-1) {
+				break
+			}
+
+			// first plot of the stretch reached at the threshold
+			offset := max(0, (threshold-st.distance+s.plotSize-1)/s.plotSize)
+			cuts = append(cuts, before+offset)
+		}
+		before += st.count
+	}
+
+	// index in the traversal of the plot flown over at the given rank
+	last := stretches[len(stretches)-1]
+	plot := func(rank int) int {
+		for _, st := range stretches {
+			if rank < st.count {
+				return st.index + rank
+			}
+			rank -= st.count
+		}
+		return last.index + last.count
+	}
+
+	sections := make([]Section, n)
+	from, rank := 0, 0
+	for k := range sections {
+		next := flown
+		if k < len(cuts) {
+			next = min(max(cuts[k], rank+1), flown-(n-k-1))
+		}
+		to := plot(next)
+		sections[k] = Section{From: from, To: to}
+		from, rank = to, next
+	}
+
+	return sections, nil
+}
+
+// CalculateFleetDistance plans one mission for each of the n drones
+// surveying a section of the estate.
+func (s *Stats) CalculateFleetDistance(ctx context.Context, n int) ([]Stats, error) {
+	sections, err := s.Partition(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	fleet := make([]Stats, 0, n)
+	for i := range sections {
+		drone := *s
+		drone.Section = &sections[i]
+		if err := drone.CalculateTotalDistance(ctx); err != nil {
+			return nil, err
+		}
+		fleet = append(fleet, drone)
+	}
+
+	return fleet, nil
+}
+
+// section returns the section to fly, the whole traversal by default.
+func (s *Stats) section() Section {
+	if s.Section != nil {
+		return *s.Section
+	}
+
+	return Section{From: 0, To: math.MaxInt}
+}
+
+// walkLevels visits every stretch of plots flown at the same altitude with
+// the index of its first plot and the distance flown from the first plot of
+// the traversal to reach it. The no-fly plots are numbered but not flown
+// over, the detours around them are not counted.
+func (s *Stats) walkLevels(ctx context.Context, visit func(index, count, distance int)) error {
+	position, distance, altitude := 0, 0, 0
+	flown := false
+	return s.Traversal.Walk(s.Estate.Length, s.Estate.Width, func(walked Run) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := s.profileLevels(walked, func(run Run, level int) error {
+			if run.Count == 0 {
+				return nil
+			}
+
+			if flown {
+				distance += s.plotSize + abs(level-altitude)
+			}
+			visit(position+walked.offset(run.X, run.Y), run.Count, distance)
+
+			distance += s.plotSize * (run.Count - 1)
+			altitude = level
+			flown = true
+			return nil
+		})
+		position += walked.Count
+		return err
+	})
+}
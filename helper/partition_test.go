@@ -0,0 +1,96 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Partition(t *testing.T) {
+	t.Run("split an empty estate evenly", func(t *testing.T) {
+		stats := Stats{Estate: repository.Estate{Length: 4, Width: 1}}
+
+		sections, err := stats.Partition(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []Section{{From: 0, To: 2}, {From: 2, To: 4}}, sections)
+	})
+
+	t.Run("split by flight distance", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 4, Width: 1},
+			Trees:  Trees{repository.Tree{X: 1, Y: 1, Height: 29}},
+		}
+
+		sections, err := stats.Partition(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []Section{{From: 0, To: 1}, {From: 1, To: 4}}, sections)
+	})
+
+	t.Run("every plot belongs to one section", func(t *testing.T) {
+		stats := Stats{Estate: repository.Estate{Length: 3, Width: 2}}
+
+		sections, err := stats.Partition(context.Background(), 6)
+		assert.NoError(t, err)
+		for i, section := range sections {
+			assert.Equal(t, Section{From: i, To: i + 1}, section)
+		}
+	})
+
+	t.Run("every section has a plot flown over", func(t *testing.T) {
+		stats := Stats{
+			Estate: repository.Estate{Length: 4, Width: 1},
+			Areas: []repository.RestrictedArea{
+				{Kind: repository.NoFlyArea, X: 2, Y: 1, Length: 2, Width: 1},
+			},
+		}
+
+		sections, err := stats.Partition(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []Section{{From: 0, To: 3}, {From: 3, To: 4}}, sections)
+
+		_, err = stats.Partition(context.Background(), 3)
+		assert.ErrorIs(t, err, ErrInvalidFleetSize)
+	})
+
+	t.Run("failed to partition: invalid fleet size", func(t *testing.T) {
+		stats := Stats{Estate: repository.Estate{Length: 3, Width: 2}}
+
+		_, err := stats.Partition(context.Background(), 0)
+		assert.ErrorIs(t, err, ErrInvalidFleetSize)
+
+		_, err = stats.Partition(context.Background(), 7)
+		assert.ErrorIs(t, err, ErrInvalidFleetSize)
+	})
+}
+
+func Test_CalculateFleetDistance(t *testing.T) {
+	stats := Stats{
+		Estate:          repository.Estate{Length: 3, Width: 2},
+		RecordWaypoints: true,
+	}
+
+	fleet, err := stats.CalculateFleetDistance(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Len(t, fleet, 2)
+
+	assert.Equal(t, 22, fleet[0].Distance)
+	assert.Equal(t, []Leg{{
+		Start:    Rest{X: 1, Y: 1},
+		End:      Rest{X: 3, Y: 1},
+		Distance: 22,
+		Takeoff:  1,
+		Landing:  1,
+		Flight:   Flight{Horizontal: 20, Climb: 1, Descent: 1},
+	}}, fleet[0].Legs)
+
+	assert.Equal(t, 22, fleet[1].Distance)
+	assert.Equal(t, []Waypoint{
+		{X: 3, Y: 2, Altitude: 0, Distance: 0},
+		{X: 3, Y: 2, Altitude: 1, Distance: 1},
+		{X: 2, Y: 2, Altitude: 1, Distance: 11},
+		{X: 1, Y: 2, Altitude: 1, Distance: 21},
+		{X: 1, Y: 2, Altitude: 0, Distance: 22},
+	}, fleet[1].Waypoints)
+}
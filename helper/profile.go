@@ -0,0 +1,159 @@
+package helper
+
+import "context"
+
+// DefaultMaxDip is the number of plots of the longest dip the smoothed
+// profile flies straight over when MaxDip is not set.
+const DefaultMaxDip = 3
+
+// FlightProfile is how closely the drone follows the trees and the ground.
+type FlightProfile string
+
+const (
+	// TerrainFollowing flies every plot at the lowest safe altitude,
+	// climbing and descending over every tree.
+	TerrainFollowing FlightProfile = "terrain"
+
+	// Smoothed holds the altitude over the dips of a run between two higher
+	// stretches, when they are at most MaxDip plots long.
+	Smoothed FlightProfile = "smooth"
+
+	// FixedAltitude flies the whole mission at the altitude of the tallest
+	// tree or obstacle over the highest ground.
+	FixedAltitude FlightProfile = "fixed"
+)
+
+// FlightProfiles lists the available profiles, the first one is the default.
+var FlightProfiles = []FlightProfile{TerrainFollowing, Smoothed, FixedAltitude}
+
+func GetFlightProfile(name string) (FlightProfile, bool) {
+	for _, profile := range FlightProfiles {
+		if string(profile) == name {
+			return profile, true
+		}
+	}
+
+	return "", false
+}
+
+// stretch is a part of a run flown at the same altitude.
+type stretch struct {
+	run      Run
+	altitude int
+}
+
+// dip is a group of consecutive stretches of a run flown at the same
+// altitude, from index first to index last, covering count plots.
+type dip struct {
+	first    int
+	last     int
+	count    int
+	altitude int
+}
+
+// profileLevels splits a run into the stretches of plots flown at the same
+// altitude with the flight profile of the plan.
+func (s *Stats) profileLevels(run Run, visit func(run Run, altitude int) error) error {
+	switch s.FlightProfile {
+	case FixedAltitude:
+		return s.levels(run, func(run Run, _ int) error {
+			return visit(run, s.transitAltitude)
+		})
+	case Smoothed:
+		return s.smoothLevels(run, visit)
+	default:
+		return s.levels(run, visit)
+	}
+}
+
+// smoothLevels splits a run like levels, then raises every dip between two
+// higher stretches to the lower of them when it is at most MaxDip plots
+// long. Flying straight over a dip saves twice its depth and never changes
+// the level distance, so it always lowers the distance and the energy while
+// the drone stays above the required clearance. Stretches separated by
+// skipped plots are smoothed apart.
+func (s *Stats) smoothLevels(run Run, visit func(run Run, altitude int) error) error {
+	var stretches []stretch
+	err := s.levels(run, func(run Run, altitude int) error {
+		if run.Count > 0 {
+			stretches = append(stretches, stretch{run: run, altitude: altitude})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	maxDip := s.MaxDip
+	if maxDip <= 0 {
+		maxDip = DefaultMaxDip
+	}
+
+	// stack of the groups left of the current stretch, every group is
+	// either higher than the next one or too long to be raised
+	var stack []dip
+	settle := func() {
+		for _, d := range stack {
+			for i := d.first; i <= d.last; i++ {
+				stretches[i].altitude = d.altitude
+			}
+		}
+		stack = stack[:0]
+	}
+
+	for i, st := range stretches {
+		if i > 0 {
+			previous := stretches[i-1].run.skip(stretches[i-1].run.Count-1, 1)
+			if abs(st.run.X-previous.X)+abs(st.run.Y-previous.Y) != 1 {
+				settle()
+			}
+		}
+
+		next := dip{first: i, last: i, count: st.run.Count, altitude: st.altitude}
+		for len(stack) >= 2 {
+			top, below := stack[len(stack)-1], stack[len(stack)-2]
+			if top.altitude >= next.altitude || top.altitude >= below.altitude || top.count > maxDip {
+				break
+			}
+
+			stack = stack[:len(stack)-1]
+			if below.altitude <= next.altitude {
+				stack[len(stack)-1] = dip{first: below.first, last: top.last, count: below.count + top.count, altitude: below.altitude}
+			} else {
+				next = dip{first: top.first, last: next.last, count: top.count + next.count, altitude: next.altitude}
+			}
+		}
+
+		if n := len(stack); n > 0 && stack[n-1].altitude == next.altitude {
+			stack[n-1] = dip{first: stack[n-1].first, last: next.last, count: stack[n-1].count + next.count, altitude: next.altitude}
+		} else {
+			stack = append(stack, next)
+		}
+	}
+	settle()
+
+	for _, st := range stretches {
+		if err := visit(st.run, st.altitude); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ProfileDistances plans the same mission following every tree and the
+// ground, and at a fixed altitude, and returns both distances.
+func (s *Stats) ProfileDistances(ctx context.Context) (int, int, error) {
+	distances := make([]int, 0, 2)
+	for _, profile := range []FlightProfile{TerrainFollowing, FixedAltitude} {
+		baseline := *s
+		baseline.FlightProfile = profile
+		baseline.RecordWaypoints = false
+		if err := baseline.CalculateTotalDistance(ctx); err != nil {
+			return 0, 0, err
+		}
+		distances = append(distances, baseline.Distance)
+	}
+
+	return distances[0], distances[1], nil
+}
@@ -0,0 +1,74 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FlightProfiles(t *testing.T) {
+	// trees of 10 meters on plots 1 and 3 and of 20 meters on plot 7, the
+	// drone flies at 11, 1, 11, 1, 1, 1 and 21 meters following them
+	stats := Stats{
+		Estate: repository.Estate{Length: 7, Width: 1},
+		Trees: Trees{
+			repository.Tree{X: 1, Y: 1, Height: 10},
+			repository.Tree{X: 3, Y: 1, Height: 10},
+			repository.Tree{X: 7, Y: 1, Height: 20},
+		},
+	}
+
+	t.Run("terrain following", func(t *testing.T) {
+		terrain := stats
+		assert.NoError(t, terrain.CalculateTotalDistance(context.Background()))
+		assert.Equal(t, 1+10+(10+10)*3+10+10+(10+20)+21, terrain.Distance)
+	})
+
+	t.Run("smooth only the short dips", func(t *testing.T) {
+		smoothed := stats
+		smoothed.FlightProfile = Smoothed
+		smoothed.MaxDip = 2
+		smoothed.RecordWaypoints = true
+		assert.NoError(t, smoothed.CalculateTotalDistance(context.Background()))
+
+		// plot 2 is flown at 11 meters, plots 4 to 6 are too long a dip
+		assert.Equal(t, 1+10+10+10+(10+10)+10+10+(10+20)+21, smoothed.Distance)
+		assert.Equal(t, Waypoint{X: 2, Y: 1, Altitude: 11, Distance: 21}, smoothed.Waypoints[3])
+		assert.Equal(t, Waypoint{X: 4, Y: 1, Altitude: 11, Distance: 41}, smoothed.Waypoints[5])
+		assert.Equal(t, 1, smoothed.Waypoints[6].Altitude)
+	})
+
+	t.Run("smooth every dip up to the default length", func(t *testing.T) {
+		smoothed := stats
+		smoothed.FlightProfile = Smoothed
+		assert.NoError(t, smoothed.CalculateTotalDistance(context.Background()))
+		assert.Equal(t, 1+10+10*5+(10+10)+21, smoothed.Distance)
+
+		terrain, fixed, err := smoothed.ProfileDistances(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 142, terrain)
+		assert.Equal(t, 1+20+10*6+21, fixed)
+	})
+
+	t.Run("never raise the ends of a run", func(t *testing.T) {
+		smoothed := Stats{
+			Estate:        repository.Estate{Length: 3, Width: 1},
+			Trees:         Trees{repository.Tree{X: 2, Y: 1, Height: 10}},
+			FlightProfile: Smoothed,
+		}
+		assert.NoError(t, smoothed.CalculateTotalDistance(context.Background()))
+		assert.Equal(t, 1+(10+10)*2+1, smoothed.Distance)
+	})
+
+	t.Run("fixed altitude", func(t *testing.T) {
+		fixed := stats
+		fixed.FlightProfile = FixedAltitude
+		fixed.RecordWaypoints = true
+		assert.NoError(t, fixed.CalculateTotalDistance(context.Background()))
+		for _, waypoint := range fixed.Waypoints[2 : len(fixed.Waypoints)-2] {
+			assert.Equal(t, 21, waypoint.Altitude)
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package helper
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+)
+
+const (
+	// MaxElevationPlots caps the number of plots of an estate with an
+	// elevation grid.
+	MaxElevationPlots = 1_000_000
+
+	// MaxElevation caps the elevation in meters of the ground, above or
+	// below the reference level.
+	MaxElevation = 10_000
+)
+
+var (
+	ErrElevationTooLarge = errors.New("estate is too large for an elevation grid")
+	ErrInvalidElevation  = errors.New("elevation grid does not match the estate")
+)
+
+// ParseElevation reads the elevation grid of an estate in meters, one line
+// per row of plots starting with row 1, the values of a line separated by
+// commas or spaces. The values are rounded to the nearest meter.
+func ParseElevation(estate repository.Estate, r io.Reader) (repository.Elevation, error) {
+	if estate.Length*estate.Width > MaxElevationPlots {
+		return nil, ErrElevationTooLarge
+	}
+
+	elevation := make(repository.Elevation, 0, estate.Width)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		fields := strings.FieldsFunc(scanner.Text(), func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		})
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != estate.Length || len(elevation) == estate.Width {
+			return nil, ErrInvalidElevation
+		}
+
+		row := make([]int, 0, len(fields))
+		for _, field := range fields {
+			value, err := strconv.ParseFloat(field, 64)
+			if err != nil || math.IsNaN(value) || math.Abs(value) > MaxElevation {
+				return nil, ErrInvalidElevation
+			}
+			row = append(row, int(math.Round(value)))
+		}
+		elevation = append(elevation, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, ErrInvalidElevation
+	}
+	if len(elevation) != estate.Width {
+		return nil, ErrInvalidElevation
+	}
+
+	return elevation, nil
+}
+
+// ElevationRange returns the lowest and the highest elevation of the grid,
+// 0 for flat ground.
+func ElevationRange(elevation repository.Elevation) (int, int) {
+	if len(elevation) == 0 {
+		return 0, 0
+	}
+
+	lowest, highest := math.MaxInt, math.MinInt
+	for _, row := range elevation {
+		for _, value := range row {
+			lowest, highest = min(lowest, value), max(highest, value)
+		}
+	}
+
+	return lowest, highest
+}
+
+// ground returns the elevation of plot (x, y), a plot outside the estate
+// takes the elevation of the nearest plot of the estate.
+func (s *Stats) ground(x, y int) int {
+	if len(s.Elevation) == 0 {
+		return 0
+	}
+
+	row := s.Elevation[min(max(y, 1), len(s.Elevation))-1]
+	if len(row) == 0 {
+		return 0
+	}
+
+	return row[min(max(x, 1), len(row))-1]
+}
@@ -0,0 +1,89 @@
+package helper
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseElevation(t *testing.T) {
+	estate := repository.Estate{Length: 3, Width: 2}
+
+	t.Run("csv and ascii grid", func(t *testing.T) {
+		elevation, err := ParseElevation(estate, strings.NewReader("10,12.4,12.6\n\n 9  11 -2 \n"))
+		assert.NoError(t, err)
+		assert.Equal(t, repository.Elevation{{10, 12, 13}, {9, 11, -2}}, elevation)
+
+		lowest, highest := ElevationRange(elevation)
+		assert.Equal(t, -2, lowest)
+		assert.Equal(t, 13, highest)
+	})
+
+	t.Run("grid not matching the estate", func(t *testing.T) {
+		for _, grid := range []string{"1,2,3", "1,2,3\n1,2\n", "1,2,3\n1,2,3\n1,2,3\n", "1,2,3\n1,x,3\n", "1,2,3\n1,2,10001\n"} {
+			_, err := ParseElevation(estate, strings.NewReader(grid))
+			assert.ErrorIs(t, err, ErrInvalidElevation, grid)
+		}
+	})
+
+	t.Run("estate too large", func(t *testing.T) {
+		_, err := ParseElevation(repository.Estate{Length: 1001, Width: 1000}, strings.NewReader(""))
+		assert.ErrorIs(t, err, ErrElevationTooLarge)
+	})
+}
+
+func Test_Terrain(t *testing.T) {
+	stats := Stats{
+		Estate:          repository.Estate{Length: 3, Width: 1},
+		Elevation:       repository.Elevation{{0, 10, 0}},
+		RecordWaypoints: true,
+	}
+
+	t.Run("climb over a hill", func(t *testing.T) {
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+		// the drone climbs 10 meters up and down the hill, flat ground is 22
+		assert.Equal(t, 42, stats.Distance)
+		assert.Equal(t, []Waypoint{
+			{X: 1, Y: 1, Altitude: 0, Ground: 0, Distance: 0},
+			{X: 1, Y: 1, Altitude: 1, Ground: 0, Distance: 1},
+			{X: 1, Y: 1, Altitude: 11, Ground: 0, Distance: 11},
+			{X: 2, Y: 1, Altitude: 11, Ground: 10, Distance: 21},
+			{X: 3, Y: 1, Altitude: 11, Ground: 0, Distance: 31},
+			{X: 3, Y: 1, Altitude: 1, Ground: 0, Distance: 41},
+			{X: 3, Y: 1, Altitude: 0, Ground: 0, Distance: 42},
+		}, stats.Waypoints)
+		assert.Equal(t, 1, stats.Waypoints[3].RelativeAltitude())
+	})
+
+	t.Run("land on a hilltop", func(t *testing.T) {
+		latitude, longitude := 1.0, 100.0
+		hill := stats
+		hill.Estate.OriginLatitude, hill.Estate.OriginLongitude = &latitude, &longitude
+		hill.Elevation = repository.Elevation{{5, 10, 20}}
+		assert.NoError(t, hill.CalculateTotalDistance(context.Background()))
+
+		mission, err := hill.Mission()
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, mission[0].Altitude)
+		assert.Equal(t, MavCmdNavTakeoff, mission[1].Command)
+		assert.Equal(t, 16.0, mission[len(mission)-2].Altitude)
+		assert.Equal(t, MavCmdNavLand, mission[len(mission)-1].Command)
+		assert.Equal(t, 15.0, mission[len(mission)-1].Altitude)
+	})
+
+	t.Run("rest on the ground of the plot", func(t *testing.T) {
+		rests := stats
+		rests.RecordWaypoints = false
+		rests.CountRests = true
+		rests.MaxDistance = 25
+		assert.NoError(t, rests.CalculateTotalDistance(context.Background()))
+
+		assert.Len(t, rests.Legs, 2)
+		assert.Equal(t, Rest{X: 2, Y: 1}, rests.Legs[0].End)
+		assert.Equal(t, 22, rests.Legs[0].Distance)
+	})
+}
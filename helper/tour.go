@@ -0,0 +1,142 @@
+package helper
+
+import (
+	"context"
+	"sort"
+)
+
+// MaxTourOptimization caps the number of trees whose tour is shortened with
+// the nearest neighbour and 2-opt heuristics, larger estates visit their
+// trees row by row.
+const MaxTourOptimization = 2000
+
+// maxTwoOptPasses caps the passes of the 2-opt heuristic, each pass costs
+// O(n²) and the later ones barely shorten the tour.
+const maxTwoOptPasses = 20
+
+// TreeTour flies from plot (1, 1) over the planted plots only, in the order
+// of a short tour. Between two trees, the drone flies along the length and
+// then along the width of the estate, over any plot on its way.
+type TreeTour struct {
+	stops []Rest
+}
+
+// NewTreeTour orders the trees in a short tour starting from plot (1, 1). It
+// stops with the context error when the context is done.
+func NewTreeTour(ctx context.Context, trees Trees) (TreeTour, error) {
+	stops := make([]Rest, 0, len(trees))
+	for _, tree := range trees {
+		stops = append(stops, Rest{X: tree.X, Y: tree.Y})
+	}
+
+	if len(stops) > MaxTourOptimization {
+		sort.Slice(stops, func(i, j int) bool {
+			if stops[i].Y != stops[j].Y {
+				return stops[i].Y < stops[j].Y
+			}
+			if stops[i].Y%2 == 0 {
+				return stops[i].X > stops[j].X
+			}
+			return stops[i].X < stops[j].X
+		})
+		return TreeTour{stops: stops}, nil
+	}
+
+	tour := append([]Rest{{X: 1, Y: 1}}, nearestNeighbour(Rest{X: 1, Y: 1}, stops)...)
+	if err := twoOpt(ctx, tour); err != nil {
+		return TreeTour{}, err
+	}
+
+	return TreeTour{stops: tour[1:]}, nil
+}
+
+func (TreeTour) Name() string {
+	return "trees"
+}
+
+func (t TreeTour) Walk(length, width int, visit func(run Run) error) error {
+	if length <= 0 || width <= 0 {
+		return nil
+	}
+
+	if err := visit(Run{X: 1, Y: 1, Count: 1}); err != nil {
+		return err
+	}
+
+	from := Rest{X: 1, Y: 1}
+	for _, stop := range t.stops {
+		if dx := stop.X - from.X; dx != 0 {
+			step := dx / abs(dx)
+			if err := visit(Run{X: from.X + step, Y: from.Y, DX: step, Count: abs(dx)}); err != nil {
+				return err
+			}
+		}
+
+		if dy := stop.Y - from.Y; dy != 0 {
+			step := dy / abs(dy)
+			if err := visit(Run{X: stop.X, Y: from.Y + step, DY: step, Count: abs(dy)}); err != nil {
+				return err
+			}
+		}
+		from = stop
+	}
+
+	return nil
+}
+
+// nearestNeighbour orders the stops by always flying to the closest one
+// not visited yet.
+func nearestNeighbour(from Rest, stops []Rest) []Rest {
+	tour := make([]Rest, 0, len(stops))
+	left := append([]Rest(nil), stops...)
+	for len(left) > 0 {
+		next := 0
+		for i := range left {
+			if manhattan(from, left[i]) < manhattan(from, left[next]) {
+				next = i
+			}
+		}
+
+		from = left[next]
+		tour = append(tour, from)
+		left[next] = left[len(left)-1]
+		left = left[:len(left)-1]
+	}
+
+	return tour
+}
+
+// twoOpt shortens an open tour by reversing the parts of it that cross, the
+// first stop stays in place. It gives up after maxTwoOptPasses passes.
+func twoOpt(ctx context.Context, tour []Rest) error {
+	for pass, improved := 0, true; improved && pass < maxTwoOptPasses; pass++ {
+		improved = false
+		for i := 1; i < len(tour)-1; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			for j := i + 1; j < len(tour); j++ {
+				before := manhattan(tour[i-1], tour[i])
+				after := manhattan(tour[i-1], tour[j])
+				if j+1 < len(tour) {
+					before += manhattan(tour[j], tour[j+1])
+					after += manhattan(tour[i], tour[j+1])
+				}
+
+				if after < before {
+					for a, b := i, j; a < b; a, b = a+1, b-1 {
+						tour[a], tour[b] = tour[b], tour[a]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func manhattan(a, b Rest) int {
+	return abs(a.X-b.X) + abs(a.Y-b.Y)
+}
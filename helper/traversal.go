@@ -0,0 +1,121 @@
+package helper
+
+// Run is a straight line of Count adjacent plots, starting at plot (X, Y)
+// and moving by (DX, DY) from one plot to the next.
+type Run struct {
+	X     int
+	Y     int
+	DX    int
+	DY    int
+	Count int
+}
+
+// offset returns the number of plots flown along the run from its first
+// plot to plot (x, y) of the run.
+func (r Run) offset(x, y int) int {
+	return (x-r.X)*r.DX + (y-r.Y)*r.DY
+}
+
+// Traversal is the order in which the drone flies over the plots of an
+// estate, described as consecutive runs. Every traversal starts at plot
+// (1, 1) and only moves between adjacent plots.
+type Traversal interface {
+	Name() string
+	Walk(length, width int, visit func(run Run) error) error
+}
+
+// Traversals lists the available strategies, the first one is the default.
+var Traversals = []Traversal{RowSerpentine{}, ColumnSerpentine{}, Spiral{}}
+
+func GetTraversal(name string) (Traversal, bool) {
+	for _, traversal := range Traversals {
+		if traversal.Name() == name {
+			return traversal, true
+		}
+	}
+
+	return nil, false
+}
+
+// RowSerpentine flies along the length of the estate, moving to the next
+// row of the width at the end of each row and alternating direction.
+type RowSerpentine struct{}
+
+func (RowSerpentine) Name() string {
+	return "row"
+}
+
+func (RowSerpentine) Walk(length, width int, visit func(run Run) error) error {
+	if length <= 0 {
+		return nil
+	}
+
+	for y := 1; y <= width; y++ {
+		run := Run{X: 1, Y: y, DX: 1, Count: length}
+		if y%2 == 0 {
+			run = Run{X: length, Y: y, DX: -1, Count: length}
+		}
+
+		if err := visit(run); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ColumnSerpentine flies along the width of the estate, moving to the next
+// column of the length at the end of each column and alternating direction.
+type ColumnSerpentine struct{}
+
+func (ColumnSerpentine) Name() string {
+	return "column"
+}
+
+func (ColumnSerpentine) Walk(length, width int, visit func(run Run) error) error {
+	return RowSerpentine{}.Walk(width, length, func(run Run) error {
+		return visit(Run{X: run.Y, Y: run.X, DX: run.DY, DY: run.DX, Count: run.Count})
+	})
+}
+
+// Spiral flies around the border of the estate and keeps turning inward
+// until every plot is visited.
+type Spiral struct{}
+
+func (Spiral) Name() string {
+	return "spiral"
+}
+
+func (Spiral) Walk(length, width int, visit func(run Run) error) error {
+	left, right := 1, length
+	bottom, top := 1, width
+	for left <= right && bottom <= top {
+		if err := visit(Run{X: left, Y: bottom, DX: 1, Count: right - left + 1}); err != nil {
+			return err
+		}
+		bottom++
+
+		if bottom <= top {
+			if err := visit(Run{X: right, Y: bottom, DY: 1, Count: top - bottom + 1}); err != nil {
+				return err
+			}
+		}
+		right--
+
+		if bottom <= top && left <= right {
+			if err := visit(Run{X: right, Y: top, DX: -1, Count: right - left + 1}); err != nil {
+				return err
+			}
+		}
+		top--
+
+		if bottom <= top && left <= right {
+			if err := visit(Run{X: left, Y: top, DY: -1, Count: top - bottom + 1}); err != nil {
+				return err
+			}
+		}
+		left++
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SawitProRecruitment/UserService/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TraversalWalk(t *testing.T) {
+	walk := func(traversal Traversal, length, width int) []Rest {
+		plots := make([]Rest, 0)
+		traversal.Walk(length, width, func(run Run) error {
+			for i := 0; i < run.Count; i++ {
+				plots = append(plots, Rest{X: run.X + run.DX*i, Y: run.Y + run.DY*i})
+			}
+			return nil
+		})
+		return plots
+	}
+
+	t.Run("row serpentine", func(t *testing.T) {
+		assert.Equal(t, []Rest{
+			{X: 1, Y: 1}, {X: 2, Y: 1}, {X: 3, Y: 1},
+			{X: 3, Y: 2}, {X: 2, Y: 2}, {X: 1, Y: 2},
+		}, walk(RowSerpentine{}, 3, 2))
+	})
+
+	t.Run("column serpentine", func(t *testing.T) {
+		assert.Equal(t, []Rest{
+			{X: 1, Y: 1}, {X: 1, Y: 2},
+			{X: 2, Y: 2}, {X: 2, Y: 1},
+			{X: 3, Y: 1}, {X: 3, Y: 2},
+		}, walk(ColumnSerpentine{}, 3, 2))
+	})
+
+	t.Run("spiral", func(t *testing.T) {
+		assert.Equal(t, []Rest{
+			{X: 1, Y: 1}, {X: 2, Y: 1}, {X: 3, Y: 1},
+			{X: 3, Y: 2}, {X: 3, Y: 3},
+			{X: 2, Y: 3}, {X: 1, Y: 3},
+			{X: 1, Y: 2}, {X: 2, Y: 2},
+		}, walk(Spiral{}, 3, 3))
+	})
+
+	t.Run("spiral on a single column", func(t *testing.T) {
+		assert.Equal(t, []Rest{{X: 1, Y: 1}, {X: 1, Y: 2}, {X: 1, Y: 3}}, walk(Spiral{}, 1, 3))
+	})
+
+	t.Run("get traversal by name", func(t *testing.T) {
+		traversal, ok := GetTraversal("spiral")
+		assert.True(t, ok)
+		assert.Equal(t, Spiral{}, traversal)
+
+		_, ok = GetTraversal("zigzag")
+		assert.False(t, ok)
+	})
+}
+
+func Test_CalculateBestDistance(t *testing.T) {
+	stats := Stats{
+		Estate: repository.Estate{Length: 3, Width: 2},
+		Trees: Trees{
+			repository.Tree{X: 2, Y: 1, Height: 10},
+			repository.Tree{X: 2, Y: 2, Height: 10},
+		},
+	}
+
+	assert.NoError(t, stats.CalculateBestDistance(context.Background()))
+	assert.Equal(t, ColumnSerpentine{}, stats.Traversal)
+	assert.Equal(t, 72, stats.Distance)
+
+	stats.Traversal = RowSerpentine{}
+	assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+	assert.Equal(t, 92, stats.Distance)
+}
+
+func Test_TreeTour(t *testing.T) {
+	trees := Trees{
+		repository.Tree{X: 5, Y: 1, Height: 5},
+		repository.Tree{X: 2, Y: 1, Height: 5},
+		repository.Tree{X: 2, Y: 3, Height: 5},
+	}
+
+	t.Run("visit the trees in a short tour", func(t *testing.T) {
+		runs := make([]Run, 0)
+		tour, err := NewTreeTour(context.Background(), trees)
+		assert.NoError(t, err)
+
+		tour.Walk(5, 3, func(run Run) error {
+			runs = append(runs, run)
+			return nil
+		})
+
+		assert.Equal(t, []Run{
+			{X: 1, Y: 1, Count: 1},
+			{X: 2, Y: 1, DX: 1, Count: 1},
+			{X: 2, Y: 2, DY: 1, Count: 2},
+			{X: 3, Y: 3, DX: 1, Count: 3},
+			{X: 5, Y: 2, DY: -1, Count: 2},
+		}, runs)
+	})
+
+	t.Run("plan over the trees only", func(t *testing.T) {
+		tour, err := NewTreeTour(context.Background(), trees)
+		assert.NoError(t, err)
+
+		stats := Stats{
+			Estate:    repository.Estate{Length: 5, Width: 3},
+			Trees:     append(trees, repository.Tree{X: 4, Y: 3, Height: 10}),
+			Traversal: tour,
+		}
+
+		assert.NoError(t, stats.CalculateTotalDistance(context.Background()))
+
+		// 8 hops between the 9 plots flown over, climbing over the tree at
+		// (4, 3) on the way from (2, 3) to (5, 1)
+		assert.Equal(t, 1+80+5+5+5+5+10+10+5+6, stats.Distance)
+		assert.Equal(t, Rest{X: 5, Y: 1}, stats.Legs[0].End)
+	})
+
+	t.Run("tour of many trees", func(t *testing.T) {
+		many := make(Trees, 0, MaxTourOptimization+2)
+		for x := 1; x <= MaxTourOptimization+2; x++ {
+			many = append(many, repository.Tree{X: x, Y: 1 + x%2, Height: 1})
+		}
+
+		tour, err := NewTreeTour(context.Background(), many)
+		assert.NoError(t, err)
+		assert.Len(t, tour.stops, len(many))
+		assert.Equal(t, Rest{X: 2, Y: 1}, tour.stops[0])
+	})
+
+	t.Run("stop the tour when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := NewTreeTour(ctx, trees)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -0,0 +1,339 @@
+// Package planner plans the survey flight of a drone over the plots of an
+// estate. It does not depend on the HTTP server nor on the database: the
+// estate, its trees and the drone are given as an Input, which is never
+// modified, and the flight is returned as an Output.
+//
+// The drone flies over the center of every plot, at the clearance of the
+// drone profile above the tree or the obstacle of the plot, and lands on
+// the ground when its battery range set by MaxDistance runs out.
+package planner
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/SawitProRecruitment/UserService/helper"
+	"github.com/SawitProRecruitment/UserService/repository"
+)
+
+// ErrInvalidInput is returned when the input is not a valid estate or
+// drone, the other errors are returned when no plan can be flown.
+var (
+	ErrInvalidInput        = errors.New("invalid planner input")
+	ErrMaxDistanceTooShort = helper.ErrMaxDistanceTooShort
+	ErrNoLandingZone       = helper.ErrNoLandingZone
+	ErrNoRoute             = helper.ErrNoRoute
+	ErrPlanTooLarge        = helper.ErrPlanTooLarge
+	ErrDistanceOverflow    = helper.ErrDistanceOverflow
+)
+
+// Strategy is the order in which the plots are flown.
+type Strategy string
+
+const (
+	// Row flies along the length, alternating direction on every row.
+	Row Strategy = "row"
+	// Column flies along the width, alternating direction on every column.
+	Column Strategy = "column"
+	// Spiral flies around the border and keeps turning inward.
+	Spiral Strategy = "spiral"
+	// Trees only flies over the plots with a tree, in a short tour.
+	Trees Strategy = "trees"
+	// Auto picks the row, column or spiral plan with the lowest distance.
+	Auto Strategy = "auto"
+)
+
+// Profile is how closely the drone follows the trees and the ground.
+type Profile string
+
+const (
+	// Terrain climbs and descends over every tree and the ground.
+	Terrain Profile = "terrain"
+	// Smooth flies straight over the short dips between higher plots.
+	Smooth Profile = "smooth"
+	// Fixed flies at the altitude of the tallest tree over the highest
+	// ground.
+	Fixed Profile = "fixed"
+)
+
+// Plot is a plot of the estate, counted from plot (1, 1).
+type Plot struct {
+	X int
+	Y int
+}
+
+// Grid is the estate: Length plots along x and Width plots along y, each
+// one PlotSize meters wide. Elevation optionally holds the ground elevation
+// in meters of every plot, row y - 1 holding the plots (1, y) to
+// (Length, y).
+type Grid struct {
+	Length    int
+	Width     int
+	PlotSize  int
+	Elevation [][]int
+}
+
+// Tree is a tree of Height meters on a plot.
+type Tree struct {
+	X      int
+	Y      int
+	Height int
+}
+
+// Area is a rectangle of Length by Width plots from plot (X, Y), either
+// not to be flown over or an obstacle of Height meters.
+type Area struct {
+	X      int
+	Y      int
+	Length int
+	Width  int
+	Height int
+	NoFly  bool
+}
+
+// DroneProfile holds the flight parameters of the drone in meters, the
+// zero value being 1 meter above the trees, taking off and landing from 1
+// meter.
+type DroneProfile struct {
+	Clearance       int
+	TakeoffAltitude int
+	LandingAltitude int
+	CruiseFloor     int
+}
+
+// Input is everything the planner needs to know about the estate and the
+// drone. Only Grid is required.
+type Input struct {
+	Grid  Grid
+	Trees []Tree
+	Areas []Area
+	Drone DroneProfile
+
+	// Home is the plot, possibly outside the estate, where the drone takes
+	// off, rests and lands. Without it, the drone rests on the nearest of
+	// the LandingZones, or where it stands when there are none.
+	Home         *Plot
+	LandingZones []Plot
+
+	// MaxDistance is the distance in meters the drone flies on a single
+	// battery charge, 0 when it never needs to rest.
+	MaxDistance int
+
+	// Strategy defaults to Row, Profile to Terrain. MaxDip is the longest
+	// dip in plots flown straight over with the Smooth profile, defaults to
+	// 3.
+	Strategy Strategy
+	Profile  Profile
+	MaxDip   int
+
+	// OmitPath leaves the waypoints out of the output, for the estates too
+	// large for one waypoint per plot.
+	OmitPath bool
+}
+
+// Waypoint is a point of the path, Distance is the distance flown when the
+// drone reaches it. Altitude is measured from the reference level of the
+// elevation, Ground is the elevation of the plot under the drone.
+type Waypoint struct {
+	X        int
+	Y        int
+	Altitude int
+	Ground   int
+	Distance int
+}
+
+// Leg is the part of the flight on a single battery charge, from a takeoff
+// at Start until the landing at End. Distance includes the Takeoff and the
+// Landing.
+type Leg struct {
+	Start    Plot
+	End      Plot
+	Distance int
+	Takeoff  int
+	Landing  int
+}
+
+// Output is the planned flight. Distance leaves out the landings and
+// takeoffs of the rests between the legs, TotalDistance includes them.
+type Output struct {
+	Strategy      Strategy
+	Distance      int
+	TotalDistance int
+	Legs          []Leg
+	Path          []Waypoint
+}
+
+// Plan plans the flight of the drone over the estate. It stops with the
+// context error when the context is done.
+func Plan(ctx context.Context, input Input) (Output, error) {
+	stats, err := newStats(ctx, input)
+	if err != nil {
+		return Output{}, err
+	}
+
+	if input.Strategy == Auto {
+		err = stats.CalculateBestDistance(ctx)
+	} else {
+		err = stats.CalculateTotalDistance(ctx)
+	}
+	if err != nil {
+		return Output{}, err
+	}
+
+	output := Output{
+		Strategy:      Strategy(stats.Traversal.Name()),
+		Distance:      stats.Distance,
+		TotalDistance: stats.TotalDistance,
+		Legs:          make([]Leg, 0, len(stats.Legs)),
+	}
+	for _, leg := range stats.Legs {
+		output.Legs = append(output.Legs, Leg{
+			Start:    Plot{X: leg.Start.X, Y: leg.Start.Y},
+			End:      Plot{X: leg.End.X, Y: leg.End.Y},
+			Distance: leg.Distance,
+			Takeoff:  leg.Takeoff,
+			Landing:  leg.Landing,
+		})
+	}
+	if !input.OmitPath {
+		output.Path = make([]Waypoint, 0, len(stats.Waypoints))
+		for _, waypoint := range stats.Waypoints {
+			output.Path = append(output.Path, Waypoint{
+				X:        waypoint.X,
+				Y:        waypoint.Y,
+				Altitude: waypoint.Altitude,
+				Ground:   waypoint.Ground,
+				Distance: waypoint.Distance,
+			})
+		}
+	}
+
+	return output, nil
+}
+
+// newStats validates the input and copies it into the planner of the
+// helper package.
+func newStats(ctx context.Context, input Input) (helper.Stats, error) {
+	grid := input.Grid
+	if grid.Length <= 0 || grid.Width <= 0 || grid.PlotSize < 0 || input.MaxDistance < 0 || input.MaxDip < 0 {
+		return helper.Stats{}, ErrInvalidInput
+	}
+	if grid.Elevation != nil && !validElevation(grid) {
+		return helper.Stats{}, ErrInvalidInput
+	}
+
+	estate := repository.Estate{
+		Length:       grid.Length,
+		Width:        grid.Width,
+		PlotSize:     grid.PlotSize,
+		DroneProfile: repository.DroneProfile(input.Drone),
+	}
+	if input.Drone == (DroneProfile{}) {
+		estate.DroneProfile = helper.DefaultDroneProfile
+	}
+	profile := estate.DroneProfile
+	if profile.Clearance < 0 || profile.TakeoffAltitude <= 0 || profile.LandingAltitude <= 0 || profile.CruiseFloor <= 0 {
+		return helper.Stats{}, ErrInvalidInput
+	}
+	if input.Home != nil {
+		// home may be outside the estate, within the range of a stored one
+		home := *input.Home
+		if home.X < math.MinInt32 || home.X > math.MaxInt32 || home.Y < math.MinInt32 || home.Y > math.MaxInt32 {
+			return helper.Stats{}, ErrInvalidInput
+		}
+		estate.HomeX, estate.HomeY = &home.X, &home.Y
+	}
+
+	stats := helper.Stats{
+		Estate:          estate,
+		Trees:           make(helper.Trees, 0, len(input.Trees)),
+		Areas:           make([]repository.RestrictedArea, 0, len(input.Areas)),
+		Elevation:       repository.Elevation(grid.Elevation),
+		RecordWaypoints: !input.OmitPath,
+		MaxDip:          input.MaxDip,
+	}
+	if input.MaxDistance > 0 {
+		stats.CountRests = true
+		stats.MaxDistance = input.MaxDistance
+	}
+
+	planted := make(map[Plot]bool, len(input.Trees))
+	for _, tree := range input.Trees {
+		plot := Plot{X: tree.X, Y: tree.Y}
+		if !helper.InsideEstate(estate, tree.X, tree.Y) || tree.Height <= 0 || planted[plot] {
+			return helper.Stats{}, ErrInvalidInput
+		}
+		planted[plot] = true
+		stats.Trees = append(stats.Trees, repository.Tree{X: tree.X, Y: tree.Y, Height: tree.Height})
+	}
+
+	for _, area := range input.Areas {
+		if area.X <= 0 || area.Y <= 0 || area.Length <= 0 || area.Width <= 0 || area.Height < 0 ||
+			area.Length > grid.Length-area.X+1 || area.Width > grid.Width-area.Y+1 {
+			return helper.Stats{}, ErrInvalidInput
+		}
+		kind := repository.ObstacleArea
+		if area.NoFly {
+			kind = repository.NoFlyArea
+		}
+		stats.Areas = append(stats.Areas, repository.RestrictedArea{
+			Kind:   kind,
+			X:      area.X,
+			Y:      area.Y,
+			Length: area.Length,
+			Width:  area.Width,
+			Height: area.Height,
+		})
+	}
+
+	for _, zone := range input.LandingZones {
+		if !helper.InsideEstate(estate, zone.X, zone.Y) {
+			return helper.Stats{}, ErrInvalidInput
+		}
+		if input.Home == nil {
+			stats.LandingZones = append(stats.LandingZones, helper.Rest{X: zone.X, Y: zone.Y})
+		}
+	}
+
+	switch input.Strategy {
+	case "", Auto:
+	case Trees:
+		tour, err := helper.NewTreeTour(ctx, stats.Trees)
+		if err != nil {
+			return helper.Stats{}, err
+		}
+		stats.Traversal = tour
+	default:
+		traversal, ok := helper.GetTraversal(string(input.Strategy))
+		if !ok {
+			return helper.Stats{}, ErrInvalidInput
+		}
+		stats.Traversal = traversal
+	}
+
+	if input.Profile != "" {
+		profile, ok := helper.GetFlightProfile(string(input.Profile))
+		if !ok {
+			return helper.Stats{}, ErrInvalidInput
+		}
+		stats.FlightProfile = profile
+	}
+
+	return stats, nil
+}
+
+// validElevation reports whether the elevation has a value for every plot
+// of the grid.
+func validElevation(grid Grid) bool {
+	if len(grid.Elevation) != grid.Width {
+		return false
+	}
+	for _, row := range grid.Elevation {
+		if len(row) != grid.Length {
+			return false
+		}
+	}
+
+	return true
+}
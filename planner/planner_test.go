@@ -0,0 +1,93 @@
+package planner
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Plan(t *testing.T) {
+	input := Input{
+		Grid:  Grid{Length: 3, Width: 1},
+		Trees: []Tree{{X: 2, Y: 1, Height: 10}},
+	}
+
+	t.Run("fly over the trees", func(t *testing.T) {
+		output, err := Plan(context.Background(), input)
+		assert.NoError(t, err)
+
+		assert.Equal(t, Row, output.Strategy)
+		assert.Equal(t, 1+(10+10)*2+1, output.Distance)
+		assert.Equal(t, output.Distance, output.TotalDistance)
+		assert.Equal(t, []Leg{{Start: Plot{X: 1, Y: 1}, End: Plot{X: 3, Y: 1}, Distance: 42, Takeoff: 1, Landing: 1}}, output.Legs)
+		assert.Equal(t, []Waypoint{
+			{X: 1, Y: 1, Altitude: 0, Distance: 0},
+			{X: 1, Y: 1, Altitude: 1, Distance: 1},
+			{X: 1, Y: 1, Altitude: 11, Distance: 11},
+			{X: 2, Y: 1, Altitude: 11, Distance: 21},
+			{X: 3, Y: 1, Altitude: 11, Distance: 31},
+			{X: 3, Y: 1, Altitude: 1, Distance: 41},
+			{X: 3, Y: 1, Altitude: 0, Distance: 42},
+		}, output.Path)
+	})
+
+	t.Run("rest when the battery runs out", func(t *testing.T) {
+		limited := input
+		limited.MaxDistance = 35
+		limited.OmitPath = true
+
+		output, err := Plan(context.Background(), limited)
+		assert.NoError(t, err)
+		assert.Len(t, output.Legs, 2)
+		assert.Greater(t, output.TotalDistance, output.Distance)
+		assert.Nil(t, output.Path)
+	})
+
+	t.Run("drone profile and strategy", func(t *testing.T) {
+		custom := input
+		custom.Drone = DroneProfile{Clearance: 2, TakeoffAltitude: 1, LandingAltitude: 1, CruiseFloor: 1}
+		custom.Strategy = Auto
+
+		output, err := Plan(context.Background(), custom)
+		assert.NoError(t, err)
+		assert.Equal(t, 1+1+(10+10)*2+2, output.Distance)
+	})
+
+	t.Run("input left unchanged", func(t *testing.T) {
+		trees := []Tree{{X: 3, Y: 1, Height: 5}, {X: 1, Y: 1, Height: 10}}
+		home := Plot{X: 0, Y: 1}
+		_, err := Plan(context.Background(), Input{Grid: Grid{Length: 3, Width: 1}, Trees: trees, Home: &home})
+		assert.NoError(t, err)
+		assert.Equal(t, []Tree{{X: 3, Y: 1, Height: 5}, {X: 1, Y: 1, Height: 10}}, trees)
+		assert.Equal(t, Plot{X: 0, Y: 1}, home)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		for name, invalid := range map[string]Input{
+			"empty grid":           {},
+			"tree outside":         {Grid: Grid{Length: 3, Width: 1}, Trees: []Tree{{X: 4, Y: 1, Height: 1}}},
+			"two trees on a plot":  {Grid: Grid{Length: 3, Width: 1}, Trees: []Tree{{X: 1, Y: 1, Height: 1}, {X: 1, Y: 1, Height: 2}}},
+			"elevation too small":  {Grid: Grid{Length: 3, Width: 1, Elevation: [][]int{{1, 2}}}},
+			"unknown strategy":     {Grid: Grid{Length: 3, Width: 1}, Strategy: "zigzag"},
+			"unknown profile":      {Grid: Grid{Length: 3, Width: 1}, Profile: "hover"},
+			"negative clearance":   {Grid: Grid{Length: 3, Width: 1}, Drone: DroneProfile{Clearance: -1, TakeoffAltitude: 1, LandingAltitude: 1, CruiseFloor: 1}},
+			"area at plot 0":       {Grid: Grid{Length: 3, Width: 1}, Areas: []Area{{X: 0, Y: 1, Length: 1, Width: 1, NoFly: true}}},
+			"area past the grid":   {Grid: Grid{Length: 3, Width: 1}, Areas: []Area{{X: 2, Y: 1, Length: 3, Width: 1, NoFly: true}}},
+			"landing zone outside": {Grid: Grid{Length: 3, Width: 1}, LandingZones: []Plot{{X: 1, Y: 2}}},
+			"home too far":         {Grid: Grid{Length: 3, Width: 1}, Home: &Plot{X: math.MaxInt32 + 1, Y: 1}},
+		} {
+			_, err := Plan(context.Background(), invalid)
+			assert.ErrorIs(t, err, ErrInvalidInput, name)
+		}
+	})
+
+	t.Run("max distance too short", func(t *testing.T) {
+		short := input
+		short.MaxDistance = 5
+
+		_, err := Plan(context.Background(), short)
+		assert.ErrorIs(t, err, ErrMaxDistanceTooShort)
+	})
+}
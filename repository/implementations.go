@@ -2,40 +2,347 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 )
 
 func (r *Repository) CreateEstate(ctx context.Context, estate Estate) (id string, err error) {
-	err = r.Db.QueryRowContext(ctx, "INSERT INTO estates(length, width) VALUES ($1, $2) RETURNING id", estate.Length, estate.Width).Scan(&id)
+	err = r.Db.QueryRowContext(
+		ctx,
+		`INSERT INTO estates(length, width, plot_size, origin_latitude, origin_longitude, rotation,
+			clearance, takeoff_altitude, landing_altitude, cruise_floor, home_x, home_y, boundary)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING id`,
+		estate.Length,
+		estate.Width,
+		estate.PlotSize,
+		estate.OriginLatitude,
+		estate.OriginLongitude,
+		estate.Rotation,
+		estate.DroneProfile.Clearance,
+		estate.DroneProfile.TakeoffAltitude,
+		estate.DroneProfile.LandingAltitude,
+		estate.DroneProfile.CruiseFloor,
+		estate.HomeX,
+		estate.HomeY,
+		estate.Boundary,
+	).Scan(&id)
 	return
 }
 
 func (r *Repository) GetEstateByID(ctx context.Context, ID string) (estate Estate, err error) {
 	err = r.Db.QueryRowContext(
 		ctx,
-		"SELECT id, length, width FROM estates WHERE id = $1", ID).Scan(
+		`SELECT id, length, width, plot_size, origin_latitude, origin_longitude, rotation,
+			clearance, takeoff_altitude, landing_altitude, cruise_floor, home_x, home_y, boundary
+		FROM estates WHERE id = $1 AND deleted_at IS NULL`, ID).Scan(
+		&estate.ID,
+		&estate.Length,
+		&estate.Width,
+		&estate.PlotSize,
+		&estate.OriginLatitude,
+		&estate.OriginLongitude,
+		&estate.Rotation,
+		&estate.DroneProfile.Clearance,
+		&estate.DroneProfile.TakeoffAltitude,
+		&estate.DroneProfile.LandingAltitude,
+		&estate.DroneProfile.CruiseFloor,
+		&estate.HomeX,
+		&estate.HomeY,
+		&estate.Boundary,
+	)
+
+	return
+}
+
+func (r *Repository) ListEstates(ctx context.Context, filter EstateFilter) ([]EstateSummary, error) {
+	estates := make([]EstateSummary, 0)
+
+	key, order, comparison := "created_at", "ASC", ">"
+	if filter.SortBy == SortByArea {
+		key = "area"
+	}
+	if filter.Descending {
+		order, comparison = "DESC", "<"
+	}
+
+	var conditions []string
+	var args []any
+	bound := func(condition string, value *int) {
+		if value != nil {
+			args = append(args, *value)
+			conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+		}
+	}
+	bound("area >= $%d", filter.MinArea)
+	bound("area <= $%d", filter.MaxArea)
+	bound("tree_count >= $%d", filter.MinTrees)
+	bound("tree_count <= $%d", filter.MaxTrees)
+	if filter.After != nil {
+		var after any = filter.After.CreatedAt
+		if key == "area" {
+			after = filter.After.Area
+		}
+		args = append(args, after, filter.After.ID)
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s ($%d, $%d)", key, comparison, len(args)-1, len(args)))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, filter.Limit)
+
+	rows, err := r.Db.QueryContext(
+		ctx,
+		fmt.Sprintf(`SELECT id, length, width, plot_size, tree_count, created_at, updated_at
+		FROM (
+			SELECT e.id, e.length, e.width, e.plot_size, e.length::bigint * e.width AS area, e.created_at, e.updated_at,
+				(SELECT COUNT(*) FROM trees t WHERE t.estate_id = e.id AND t.deleted_at IS NULL AND plot_inside(e.boundary, t.x, t.y)) AS tree_count
+			FROM estates e WHERE e.deleted_at IS NULL
+		) estates %s
+		ORDER BY %s %s, id %s LIMIT $%d`, where, key, order, order, len(args)),
+		args...,
+	)
+	if err != nil {
+		return estates, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var estate EstateSummary
+		err = rows.Scan(
+			&estate.ID,
+			&estate.Length,
+			&estate.Width,
+			&estate.PlotSize,
+			&estate.TreeCount,
+			&estate.CreatedAt,
+			&estate.UpdatedAt,
+		)
+		if err != nil {
+			return estates, err
+		}
+		estates = append(estates, estate)
+	}
+
+	return estates, rows.Err()
+}
+
+func (r *Repository) GetEstateSummary(ctx context.Context, ID string) (estate EstateSummary, err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`SELECT e.id, e.length, e.width, e.plot_size,
+			(SELECT COUNT(*) FROM trees t WHERE t.estate_id = e.id AND t.deleted_at IS NULL AND plot_inside(e.boundary, t.x, t.y)) AS tree_count,
+			e.created_at, e.updated_at
+		FROM estates e WHERE e.id = $1 AND e.deleted_at IS NULL`, ID).Scan(
 		&estate.ID,
 		&estate.Length,
 		&estate.Width,
+		&estate.PlotSize,
+		&estate.TreeCount,
+		&estate.CreatedAt,
+		&estate.UpdatedAt,
 	)
 
 	return
 }
 
+// UpdateEstate changes the length, the width and the drone profile of an
+// estate and crops its elevation grid to the new size. The trees left
+// outside of the new size are archived and a grid that does not cover it is
+// cleared when archive is true, otherwise the estate is left unchanged when
+// there are any or the grid would be cleared. Either way, the trees are
+// returned along with whether the grid is cleared.
+func (r *Repository) UpdateEstate(ctx context.Context, estate Estate, archive bool) ([]Tree, bool, error) {
+	outside := make([]Tree, 0)
+
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return outside, false, err
+	}
+	defer tx.Rollback()
+
+	ID := estate.ID
+	var elevation Elevation
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT elevation FROM estates WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, ID).Scan(&elevation)
+	if err != nil {
+		return outside, false, err
+	}
+
+	// the new plots of a grown estate have no elevation
+	cropped := elevation.Crop(estate.Length, estate.Width)
+	cleared := len(elevation) > 0 && cropped == nil
+	if cleared && !archive {
+		return outside, cleared, nil
+	}
+
+	err = tx.QueryRowContext(
+		ctx,
+		`UPDATE estates SET length = $2, width = $3, elevation = $4,
+			clearance = $5, takeoff_altitude = $6, landing_altitude = $7, cruise_floor = $8, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL RETURNING id`,
+		ID,
+		estate.Length,
+		estate.Width,
+		cropped,
+		estate.DroneProfile.Clearance,
+		estate.DroneProfile.TakeoffAltitude,
+		estate.DroneProfile.LandingAltitude,
+		estate.DroneProfile.CruiseFloor,
+	).Scan(&ID)
+	if err != nil {
+		return outside, cleared, err
+	}
+
+	query := `SELECT id, estate_id, x, y, height, created_at, updated_at FROM trees
+		WHERE estate_id = $1 AND deleted_at IS NULL AND (x > $2 OR y > $3)
+		ORDER BY x, y`
+	if archive {
+		query = `UPDATE trees SET deleted_at = now(), updated_at = now()
+		WHERE estate_id = $1 AND deleted_at IS NULL AND (x > $2 OR y > $3)
+		RETURNING id, estate_id, x, y, height, created_at, updated_at`
+	}
+	rows, err := tx.QueryContext(ctx, query, ID, estate.Length, estate.Width)
+	if err != nil {
+		return outside, cleared, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var tree Tree
+		err = rows.Scan(
+			&tree.ID,
+			&tree.EstateID,
+			&tree.X,
+			&tree.Y,
+			&tree.Height,
+			&tree.CreatedAt,
+			&tree.UpdatedAt,
+		)
+		if err != nil {
+			return outside, cleared, err
+		}
+		outside = append(outside, tree)
+	}
+	if err = rows.Err(); err != nil {
+		return outside, cleared, err
+	}
+
+	if !archive && len(outside) > 0 {
+		return outside, cleared, nil
+	}
+
+	return outside, cleared, tx.Commit()
+}
+
+// DeleteEstate soft deletes an estate along with its trees, they share the
+// same deleted_at so that restoring the estate only restores them.
+func (r *Repository) DeleteEstate(ctx context.Context, ID string) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(
+		ctx,
+		`UPDATE estates SET deleted_at = now(), updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL RETURNING id`, ID).Scan(&ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE trees SET deleted_at = now(), updated_at = now()
+		WHERE estate_id = $1 AND deleted_at IS NULL`, ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RestoreEstate restores a deleted estate along with the trees deleted
+// with it, the trees deleted before stay deleted.
+func (r *Repository) RestoreEstate(ctx context.Context, ID string) error {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE trees SET deleted_at = NULL, updated_at = now()
+		WHERE estate_id = $1 AND deleted_at = (SELECT deleted_at FROM estates WHERE id = $1)`, ID)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRowContext(
+		ctx,
+		`UPDATE estates SET deleted_at = NULL, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NOT NULL RETURNING id`, ID).Scan(&ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *Repository) UpdateEstateBoundary(ctx context.Context, ID string, boundary Boundary) (err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`UPDATE estates SET boundary = $2, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL RETURNING id`, ID, boundary).Scan(&ID)
+	return
+}
+
+func (r *Repository) GetEstateElevation(ctx context.Context, ID string) (elevation Elevation, err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`SELECT elevation FROM estates WHERE id = $1 AND deleted_at IS NULL`, ID).Scan(&elevation)
+	return
+}
+
+func (r *Repository) UpdateEstateElevation(ctx context.Context, ID string, elevation Elevation) (err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`UPDATE estates SET elevation = $2, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL RETURNING id`, ID, elevation).Scan(&ID)
+	return
+}
+
+// CreateTree plants a tree, its height is recorded as its first measurement.
 func (r *Repository) CreateTree(ctx context.Context, tree Tree) (id string, err error) {
-	err = r.Db.QueryRowContext(ctx, "INSERT INTO trees(estate_id, x, y, height) VALUES ($1, $2, $3, $4) RETURNING id", tree.EstateID, tree.X, tree.Y, tree.Height).Scan(&id)
+	err = r.Db.QueryRowContext(
+		ctx,
+		`WITH tree AS (
+			INSERT INTO trees(estate_id, x, y, height) VALUES ($1, $2, $3, $4) RETURNING id, height, created_at
+		)
+		INSERT INTO tree_heights(tree_id, height, measured_at)
+		SELECT id, height, created_at FROM tree RETURNING tree_id`,
+		tree.EstateID, tree.X, tree.Y, tree.Height).Scan(&id)
 	return
 }
 
+// GetEstateStats returns the count and the max, min and median heights of
+// the trees inside the boundary of an estate. The median of an even count is
+// the mean of the two middle heights rounded down.
 func (r *Repository) GetEstateStats(ctx context.Context, ID string) (stats Stats, err error) {
 	err = r.Db.QueryRowContext(
 		ctx,
 		`SELECT 
 			COUNT(*) AS total_trees,
-			COALESCE(MAX(height), 0) AS max_height,
-			COALESCE(MIN(height), 0) AS min_height,
-			COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY height), 0) AS median_height
-		FROM trees
-		WHERE estate_id = $1`, ID).Scan(
+			COALESCE(MAX(t.height), 0) AS max_height,
+			COALESCE(MIN(t.height), 0) AS min_height,
+			COALESCE(FLOOR(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY t.height)), 0)::integer AS median_height
+		FROM trees t JOIN estates e ON e.id = t.estate_id
+		WHERE t.estate_id = $1 AND t.deleted_at IS NULL AND plot_inside(e.boundary, t.x, t.y)`, ID).Scan(
 		&stats.TotalTrees,
 		&stats.MaxHeight,
 		&stats.MinHeight,
@@ -51,7 +358,7 @@ func (r *Repository) GetEstateTrees(ctx context.Context, ID string) ([]Tree, err
 	rows, err := r.Db.QueryContext(
 		ctx,
 		`SELECT id, estate_id, x, y, height
-		FROM trees WHERE estate_id = $1
+		FROM trees WHERE estate_id = $1 AND deleted_at IS NULL
 		ORDER BY x, y`,
 		ID,
 	)
@@ -77,3 +384,467 @@ func (r *Repository) GetEstateTrees(ctx context.Context, ID string) ([]Tree, err
 
 	return trees, err
 }
+
+func (r *Repository) ListEstateTrees(ctx context.Context, estateID string, filter TreeFilter) ([]Tree, error) {
+	trees := make([]Tree, 0)
+
+	keys, order, comparison := []string{"created_at"}, "ASC", ">"
+	switch filter.SortBy {
+	case SortByPosition:
+		keys = []string{"x", "y"}
+	case SortByHeight:
+		keys = []string{"height"}
+	}
+	if filter.Descending {
+		order, comparison = "DESC", "<"
+	}
+
+	conditions := []string{"estate_id = $1", "deleted_at IS NULL"}
+	args := []any{estateID}
+	bound := func(condition string, value *int) {
+		if value != nil {
+			args = append(args, *value)
+			conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+		}
+	}
+	bound("height >= $%d", filter.MinHeight)
+	bound("height <= $%d", filter.MaxHeight)
+	bound("x >= $%d", filter.MinX)
+	bound("x <= $%d", filter.MaxX)
+	bound("y >= $%d", filter.MinY)
+	bound("y <= $%d", filter.MaxY)
+	if filter.CreatedAfter != nil {
+		args = append(args, *filter.CreatedAfter)
+		conditions = append(conditions, fmt.Sprintf("created_at > $%d", len(args)))
+	}
+	if filter.After != nil {
+		var after []string
+		for _, key := range keys {
+			switch key {
+			case "x":
+				args = append(args, filter.After.X)
+			case "y":
+				args = append(args, filter.After.Y)
+			case "height":
+				args = append(args, filter.After.Height)
+			default:
+				args = append(args, filter.After.CreatedAt)
+			}
+			after = append(after, fmt.Sprintf("$%d", len(args)))
+		}
+		args = append(args, filter.After.ID)
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s (%s, $%d)",
+			strings.Join(keys, ", "), comparison, strings.Join(after, ", "), len(args)))
+	}
+	args = append(args, filter.Limit)
+
+	var orderBy []string
+	for _, key := range append(keys, "id") {
+		orderBy = append(orderBy, key+" "+order)
+	}
+
+	rows, err := r.Db.QueryContext(
+		ctx,
+		fmt.Sprintf(`SELECT id, estate_id, x, y, height, created_at, updated_at
+		FROM trees WHERE %s
+		ORDER BY %s LIMIT $%d`, strings.Join(conditions, " AND "), strings.Join(orderBy, ", "), len(args)),
+		args...,
+	)
+	if err != nil {
+		return trees, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var tree Tree
+		err = rows.Scan(
+			&tree.ID,
+			&tree.EstateID,
+			&tree.X,
+			&tree.Y,
+			&tree.Height,
+			&tree.CreatedAt,
+			&tree.UpdatedAt,
+		)
+		if err != nil {
+			return trees, err
+		}
+		trees = append(trees, tree)
+	}
+
+	return trees, rows.Err()
+}
+
+// GetEstateTree returns a tree of an estate, deleted or not.
+func (r *Repository) GetEstateTree(ctx context.Context, estateID, ID string) (tree Tree, err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`SELECT id, estate_id, x, y, height, created_at, updated_at, deleted_at
+		FROM trees WHERE id = $1 AND estate_id = $2`, ID, estateID).Scan(
+		&tree.ID,
+		&tree.EstateID,
+		&tree.X,
+		&tree.Y,
+		&tree.Height,
+		&tree.CreatedAt,
+		&tree.UpdatedAt,
+		&tree.DeletedAt,
+	)
+
+	return
+}
+
+func (r *Repository) DeleteTree(ctx context.Context, estateID, ID string) (err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`UPDATE trees SET deleted_at = now(), updated_at = now()
+		WHERE id = $1 AND estate_id = $2 AND deleted_at IS NULL RETURNING id`, ID, estateID).Scan(&ID)
+	return
+}
+
+func (r *Repository) RestoreTree(ctx context.Context, estateID, ID string) (err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`UPDATE trees SET deleted_at = NULL, updated_at = now()
+		WHERE id = $1 AND estate_id = $2 AND deleted_at IS NOT NULL RETURNING id`, ID, estateID).Scan(&ID)
+	return
+}
+
+// UpdateTreeHeight records a new measurement of the height of a tree that is
+// not deleted and makes it the height of the tree.
+func (r *Repository) UpdateTreeHeight(ctx context.Context, estateID, ID string, height int) (tree Tree, err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`WITH tree AS (
+			UPDATE trees SET height = $3, updated_at = now()
+			WHERE id = $1 AND estate_id = $2 AND deleted_at IS NULL
+			RETURNING id, estate_id, x, y, height, created_at, updated_at
+		), measurement AS (
+			INSERT INTO tree_heights(tree_id, height, measured_at)
+			SELECT id, height, updated_at FROM tree
+		)
+		SELECT id, estate_id, x, y, height, created_at, updated_at FROM tree`, ID, estateID, height).Scan(
+		&tree.ID,
+		&tree.EstateID,
+		&tree.X,
+		&tree.Y,
+		&tree.Height,
+		&tree.CreatedAt,
+		&tree.UpdatedAt,
+	)
+
+	return
+}
+
+// GetTreeHeights returns the measurements of the height of a tree, oldest
+// first.
+func (r *Repository) GetTreeHeights(ctx context.Context, ID string) ([]TreeHeight, error) {
+	heights := make([]TreeHeight, 0)
+
+	rows, err := r.Db.QueryContext(
+		ctx,
+		`SELECT id, tree_id, height, measured_at
+		FROM tree_heights WHERE tree_id = $1
+		ORDER BY measured_at, id`,
+		ID,
+	)
+	if err != nil {
+		return heights, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var height TreeHeight
+		err = rows.Scan(
+			&height.ID,
+			&height.TreeID,
+			&height.Height,
+			&height.MeasuredAt,
+		)
+		if err != nil {
+			return heights, err
+		}
+		heights = append(heights, height)
+	}
+
+	return heights, rows.Err()
+}
+
+func (r *Repository) CreateLandingZone(ctx context.Context, zone LandingZone) (id string, err error) {
+	err = r.Db.QueryRowContext(ctx, "INSERT INTO landing_zones(estate_id, x, y) VALUES ($1, $2, $3) RETURNING id", zone.EstateID, zone.X, zone.Y).Scan(&id)
+	return
+}
+
+func (r *Repository) GetEstateLandingZones(ctx context.Context, ID string) ([]LandingZone, error) {
+	zones := make([]LandingZone, 0)
+
+	rows, err := r.Db.QueryContext(
+		ctx,
+		`SELECT id, estate_id, x, y
+		FROM landing_zones WHERE estate_id = $1 AND deleted_at IS NULL
+		ORDER BY x, y`,
+		ID,
+	)
+	if err != nil {
+		return zones, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var zone LandingZone
+		err = rows.Scan(
+			&zone.ID,
+			&zone.EstateID,
+			&zone.X,
+			&zone.Y,
+		)
+		if err != nil {
+			return zones, err
+		}
+		zones = append(zones, zone)
+	}
+
+	return zones, err
+}
+
+func (r *Repository) GetEstateLandingZone(ctx context.Context, estateID, ID string) (zone LandingZone, err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`SELECT id, estate_id, x, y
+		FROM landing_zones WHERE id = $1 AND estate_id = $2 AND deleted_at IS NULL`, ID, estateID).Scan(
+		&zone.ID,
+		&zone.EstateID,
+		&zone.X,
+		&zone.Y,
+	)
+	return
+}
+
+func (r *Repository) DeleteLandingZone(ctx context.Context, estateID, ID string) (err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`UPDATE landing_zones SET deleted_at = now()
+		WHERE id = $1 AND estate_id = $2 AND deleted_at IS NULL RETURNING id`, ID, estateID).Scan(&ID)
+	return
+}
+
+func (r *Repository) CreateRestrictedArea(ctx context.Context, area RestrictedArea) (id string, err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`INSERT INTO restricted_areas(estate_id, kind, x, y, length, width, height)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+		area.EstateID,
+		area.Kind,
+		area.X,
+		area.Y,
+		area.Length,
+		area.Width,
+		area.Height,
+	).Scan(&id)
+	return
+}
+
+func (r *Repository) GetEstateRestrictedAreas(ctx context.Context, ID string) ([]RestrictedArea, error) {
+	areas := make([]RestrictedArea, 0)
+
+	rows, err := r.Db.QueryContext(
+		ctx,
+		`SELECT id, estate_id, kind, x, y, length, width, height
+		FROM restricted_areas WHERE estate_id = $1 AND deleted_at IS NULL
+		ORDER BY x, y`,
+		ID,
+	)
+	if err != nil {
+		return areas, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var area RestrictedArea
+		err = rows.Scan(
+			&area.ID,
+			&area.EstateID,
+			&area.Kind,
+			&area.X,
+			&area.Y,
+			&area.Length,
+			&area.Width,
+			&area.Height,
+		)
+		if err != nil {
+			return areas, err
+		}
+		areas = append(areas, area)
+	}
+
+	return areas, err
+}
+
+func (r *Repository) GetEstateRestrictedArea(ctx context.Context, estateID, ID string) (area RestrictedArea, err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`SELECT id, estate_id, kind, x, y, length, width, height
+		FROM restricted_areas WHERE id = $1 AND estate_id = $2 AND deleted_at IS NULL`, ID, estateID).Scan(
+		&area.ID,
+		&area.EstateID,
+		&area.Kind,
+		&area.X,
+		&area.Y,
+		&area.Length,
+		&area.Width,
+		&area.Height,
+	)
+	return
+}
+
+func (r *Repository) DeleteRestrictedArea(ctx context.Context, estateID, ID string) (err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`UPDATE restricted_areas SET deleted_at = now()
+		WHERE id = $1 AND estate_id = $2 AND deleted_at IS NULL RETURNING id`, ID, estateID).Scan(&ID)
+	return
+}
+
+func (r *Repository) CreateDrone(ctx context.Context, drone Drone) (id string, err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`INSERT INTO drones(model, range, speed, climb_rate, descend_rate,
+			horizontal_energy, climb_energy, descend_energy, clearance)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`,
+		drone.Model,
+		drone.Range,
+		drone.Speed,
+		drone.ClimbRate,
+		drone.DescendRate,
+		drone.HorizontalEnergy,
+		drone.ClimbEnergy,
+		drone.DescendEnergy,
+		drone.Clearance,
+	).Scan(&id)
+	return
+}
+
+func (r *Repository) GetDrones(ctx context.Context) ([]Drone, error) {
+	drones := make([]Drone, 0)
+
+	rows, err := r.Db.QueryContext(
+		ctx,
+		`SELECT id, model, range, speed, climb_rate, descend_rate,
+			horizontal_energy, climb_energy, descend_energy, clearance
+		FROM drones WHERE deleted_at IS NULL
+		ORDER BY created_at, id`,
+	)
+	if err != nil {
+		return drones, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var drone Drone
+		err = rows.Scan(
+			&drone.ID,
+			&drone.Model,
+			&drone.Range,
+			&drone.Speed,
+			&drone.ClimbRate,
+			&drone.DescendRate,
+			&drone.HorizontalEnergy,
+			&drone.ClimbEnergy,
+			&drone.DescendEnergy,
+			&drone.Clearance,
+		)
+		if err != nil {
+			return drones, err
+		}
+		drones = append(drones, drone)
+	}
+
+	return drones, err
+}
+
+func (r *Repository) GetDroneByID(ctx context.Context, ID string) (drone Drone, err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`SELECT id, model, range, speed, climb_rate, descend_rate,
+			horizontal_energy, climb_energy, descend_energy, clearance
+		FROM drones WHERE id = $1 AND deleted_at IS NULL`, ID).Scan(
+		&drone.ID,
+		&drone.Model,
+		&drone.Range,
+		&drone.Speed,
+		&drone.ClimbRate,
+		&drone.DescendRate,
+		&drone.HorizontalEnergy,
+		&drone.ClimbEnergy,
+		&drone.DescendEnergy,
+		&drone.Clearance,
+	)
+
+	return
+}
+
+func (r *Repository) UpdateDrone(ctx context.Context, drone Drone) (err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`UPDATE drones
+		SET model = $2, range = $3, speed = $4, climb_rate = $5, descend_rate = $6,
+			horizontal_energy = $7, climb_energy = $8, descend_energy = $9, clearance = $10, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL RETURNING id`,
+		drone.ID,
+		drone.Model,
+		drone.Range,
+		drone.Speed,
+		drone.ClimbRate,
+		drone.DescendRate,
+		drone.HorizontalEnergy,
+		drone.ClimbEnergy,
+		drone.DescendEnergy,
+		drone.Clearance,
+	).Scan(&drone.ID)
+	return
+}
+
+func (r *Repository) DeleteDrone(ctx context.Context, ID string) (err error) {
+	err = r.Db.QueryRowContext(
+		ctx,
+		`UPDATE drones SET deleted_at = now()
+		WHERE id = $1 AND deleted_at IS NULL RETURNING id`, ID).Scan(&ID)
+	return
+}
+
+// PurgeDeleted permanently deletes the records deleted before the given
+// time, along with everything of the estates among them, and returns how
+// many rows were deleted.
+func (r *Repository) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	tx, err := r.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var purged int64
+	for _, query := range []string{
+		`DELETE FROM tree_heights WHERE tree_id IN (SELECT id FROM trees WHERE deleted_at < $1
+			OR estate_id IN (SELECT id FROM estates WHERE deleted_at < $1))`,
+		`DELETE FROM trees WHERE deleted_at < $1
+			OR estate_id IN (SELECT id FROM estates WHERE deleted_at < $1)`,
+		`DELETE FROM landing_zones WHERE deleted_at < $1
+			OR estate_id IN (SELECT id FROM estates WHERE deleted_at < $1)`,
+		`DELETE FROM restricted_areas WHERE deleted_at < $1
+			OR estate_id IN (SELECT id FROM estates WHERE deleted_at < $1)`,
+		`DELETE FROM estates WHERE deleted_at < $1`,
+		`DELETE FROM drones WHERE deleted_at < $1`,
+	} {
+		result, err := tx.ExecContext(ctx, query, before)
+		if err != nil {
+			return 0, err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		purged += rows
+	}
+
+	return purged, tx.Commit()
+}
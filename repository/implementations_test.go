@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -19,8 +20,10 @@ func Test_CreateEstate(t *testing.T) {
 	t.Run("failed test case: database error", func(t *testing.T) {
 		estate := Estate{Length: 15, Width: 25}
 
-		mock.ExpectQuery(`INSERT INTO estates\(length, width\) VALUES \(\$1, \$2\) RETURNING id`).
-			WithArgs(estate.Length, estate.Width).
+		mock.ExpectQuery(`INSERT INTO estates\(length, width, plot_size, origin_latitude, origin_longitude, rotation, clearance, takeoff_altitude, landing_altitude, cruise_floor, home_x, home_y, boundary\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13\) RETURNING id`).
+			WithArgs(estate.Length, estate.Width, estate.PlotSize, estate.OriginLatitude, estate.OriginLongitude, estate.Rotation,
+				estate.DroneProfile.Clearance, estate.DroneProfile.TakeoffAltitude, estate.DroneProfile.LandingAltitude, estate.DroneProfile.CruiseFloor,
+				estate.HomeX, estate.HomeY, nil).
 			WillReturnError(sql.ErrConnDone)
 
 		id, err := repo.CreateEstate(context.Background(), estate)
@@ -29,11 +32,26 @@ func Test_CreateEstate(t *testing.T) {
 	})
 
 	t.Run("success test case", func(t *testing.T) {
-		estate := Estate{Length: 10, Width: 20}
+		latitude, longitude := 1.2345, 103.8198
+		homeX, homeY := 0, 1
+		estate := Estate{
+			Length:          10,
+			Width:           20,
+			PlotSize:        10,
+			OriginLatitude:  &latitude,
+			OriginLongitude: &longitude,
+			Rotation:        90,
+			DroneProfile:    DroneProfile{Clearance: 2, TakeoffAltitude: 5, LandingAltitude: 3, CruiseFloor: 4},
+			HomeX:           &homeX,
+			HomeY:           &homeY,
+			Boundary:        Boundary{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 20}},
+		}
 		estateID := "some-uuid"
 
-		mock.ExpectQuery(`INSERT INTO estates\(length, width\) VALUES \(\$1, \$2\) RETURNING id`).
-			WithArgs(estate.Length, estate.Width).
+		mock.ExpectQuery(`INSERT INTO estates\(length, width, plot_size, origin_latitude, origin_longitude, rotation, clearance, takeoff_altitude, landing_altitude, cruise_floor, home_x, home_y, boundary\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13\) RETURNING id`).
+			WithArgs(estate.Length, estate.Width, estate.PlotSize, estate.OriginLatitude, estate.OriginLongitude, estate.Rotation,
+				estate.DroneProfile.Clearance, estate.DroneProfile.TakeoffAltitude, estate.DroneProfile.LandingAltitude, estate.DroneProfile.CruiseFloor,
+				estate.HomeX, estate.HomeY, []byte(`[{"x":0,"y":0},{"x":10,"y":0},{"x":0,"y":20}]`)).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
 
 		id, err := repo.CreateEstate(context.Background(), estate)
@@ -43,6 +61,400 @@ func Test_CreateEstate(t *testing.T) {
 
 }
 
+func Test_GetEstateByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "some-uuid"
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id, length, width, plot_size, origin_latitude, origin_longitude, rotation, clearance, takeoff_altitude, landing_altitude, cruise_floor, home_x, home_y, boundary FROM estates WHERE id = \$1 AND deleted_at IS NULL`).
+			WithArgs(estateID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetEstateByID(context.Background(), estateID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		latitude, longitude := 1.2345, 103.8198
+		mock.ExpectQuery(`SELECT id, length, width, plot_size, origin_latitude, origin_longitude, rotation, clearance, takeoff_altitude, landing_altitude, cruise_floor, home_x, home_y, boundary FROM estates WHERE id = \$1 AND deleted_at IS NULL`).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "length", "width", "plot_size", "origin_latitude", "origin_longitude", "rotation",
+				"clearance", "takeoff_altitude", "landing_altitude", "cruise_floor", "home_x", "home_y", "boundary"}).
+				AddRow(estateID, 10, 20, 5, latitude, longitude, 45.0, 2, 5, 3, 4, nil, nil, []byte(`[{"x":0,"y":0},{"x":10,"y":0},{"x":0,"y":20}]`)))
+
+		estate, err := repo.GetEstateByID(context.Background(), estateID)
+		assert.NoError(t, err)
+		assert.Equal(t, Estate{
+			ID:              estateID,
+			Length:          10,
+			Width:           20,
+			PlotSize:        5,
+			OriginLatitude:  &latitude,
+			OriginLongitude: &longitude,
+			Rotation:        45,
+			DroneProfile:    DroneProfile{Clearance: 2, TakeoffAltitude: 5, LandingAltitude: 3, CruiseFloor: 4},
+			Boundary:        Boundary{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 20}},
+		}, estate)
+	})
+}
+
+func Test_ListEstates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	columns := []string{"id", "length", "width", "plot_size", "tree_count", "created_at", "updated_at"}
+	query := `SELECT id, length, width, plot_size, tree_count, created_at, updated_at FROM \( ` +
+		`SELECT e.id, e.length, e.width, e.plot_size, e.length::bigint \* e.width AS area, e.created_at, e.updated_at, ` +
+		`\(SELECT COUNT\(\*\) FROM trees t WHERE t.estate_id = e.id AND t.deleted_at IS NULL AND plot_inside\(e.boundary, t.x, t.y\)\) AS tree_count ` +
+		`FROM estates e WHERE e.deleted_at IS NULL \) estates `
+
+	t.Run("failed case: db error", func(t *testing.T) {
+		mock.ExpectQuery(query + `ORDER BY created_at ASC, id ASC LIMIT \$1`).
+			WithArgs(10).
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.ListEstates(context.Background(), EstateFilter{SortBy: SortByCreatedAt, Limit: 10})
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		minArea, maxTrees := 50, 3
+		filter := EstateFilter{
+			SortBy:     SortByArea,
+			Descending: true,
+			MinArea:    &minArea,
+			MaxTrees:   &maxTrees,
+			After:      &EstateCursor{ID: "estate-0", Area: 200},
+			Limit:      2,
+		}
+
+		mock.ExpectQuery(query+`WHERE area >= \$1 AND tree_count <= \$2 AND \(area, id\) < \(\$3, \$4\) ORDER BY area DESC, id DESC LIMIT \$5`).
+			WithArgs(50, 3, 200, "estate-0", 2).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("estate-1", 10, 20, 10, 3, createdAt, createdAt).
+				AddRow("estate-2", 5, 10, 5, 0, createdAt, createdAt))
+
+		estates, err := repo.ListEstates(context.Background(), filter)
+		assert.NoError(t, err)
+		assert.Equal(t, []EstateSummary{
+			{ID: "estate-1", Length: 10, Width: 20, PlotSize: 10, TreeCount: 3, CreatedAt: createdAt, UpdatedAt: createdAt},
+			{ID: "estate-2", Length: 5, Width: 10, PlotSize: 5, TreeCount: 0, CreatedAt: createdAt, UpdatedAt: createdAt},
+		}, estates)
+	})
+}
+
+func Test_GetEstateSummary(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "some-uuid"
+	query := `SELECT e.id, e.length, e.width, e.plot_size, ` +
+		`\(SELECT COUNT\(\*\) FROM trees t WHERE t.estate_id = e.id AND t.deleted_at IS NULL AND plot_inside\(e.boundary, t.x, t.y\)\) AS tree_count, ` +
+		`e.created_at, e.updated_at FROM estates e WHERE e.id = \$1 AND e.deleted_at IS NULL`
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(estateID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetEstateSummary(context.Background(), estateID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		updatedAt := createdAt.Add(time.Hour)
+		mock.ExpectQuery(query).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "length", "width", "plot_size", "tree_count", "created_at", "updated_at"}).
+				AddRow(estateID, 10, 20, 5, 7, createdAt, updatedAt))
+
+		estate, err := repo.GetEstateSummary(context.Background(), estateID)
+		assert.NoError(t, err)
+		assert.Equal(t, EstateSummary{ID: estateID, Length: 10, Width: 20, PlotSize: 5, TreeCount: 7, CreatedAt: createdAt, UpdatedAt: updatedAt}, estate)
+	})
+}
+
+func Test_UpdateEstate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "some-uuid"
+	columns := []string{"id", "estate_id", "x", "y", "height", "created_at", "updated_at"}
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	estate := Estate{ID: estateID, Length: 5, Width: 5, DroneProfile: DroneProfile{Clearance: 2, TakeoffAltitude: 3, LandingAltitude: 4, CruiseFloor: 5}}
+	selectElevation := `SELECT elevation FROM estates WHERE id = \$1 AND deleted_at IS NULL FOR UPDATE`
+	update := `UPDATE estates SET length = \$2, width = \$3, elevation = \$4, ` +
+		`clearance = \$5, takeoff_altitude = \$6, landing_altitude = \$7, cruise_floor = \$8, updated_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`
+	selectOutside := `SELECT id, estate_id, x, y, height, created_at, updated_at FROM trees WHERE estate_id = \$1 AND deleted_at IS NULL AND \(x > \$2 OR y > \$3\) ORDER BY x, y`
+	archiveOutside := `UPDATE trees SET deleted_at = now\(\), updated_at = now\(\) WHERE estate_id = \$1 AND deleted_at IS NULL AND \(x > \$2 OR y > \$3\) RETURNING id, estate_id, x, y, height, created_at, updated_at`
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectElevation).
+			WithArgs(estateID).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, _, err := repo.UpdateEstate(context.Background(), estate, false)
+		assert.Equal(t, sql.ErrNoRows, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success test case: trees outside are returned and nothing is changed", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectElevation).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"elevation"}).AddRow(nil))
+		mock.ExpectQuery(update).
+			WithArgs(estateID, 5, 5, nil, 2, 3, 4, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+		mock.ExpectQuery(selectOutside).
+			WithArgs(estateID, 5, 5).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("tree-1", estateID, 6, 1, 10, createdAt, createdAt))
+		mock.ExpectRollback()
+
+		outside, cleared, err := repo.UpdateEstate(context.Background(), estate, false)
+		assert.NoError(t, err)
+		assert.False(t, cleared)
+		assert.Equal(t, []Tree{{ID: "tree-1", EstateID: estateID, X: 6, Y: 1, Height: 10, CreatedAt: createdAt, UpdatedAt: createdAt}}, outside)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success test case: elevation grid not covering the new size is left unchanged", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectElevation).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"elevation"}).AddRow([]byte(`[[1,2,3,4,5]]`)))
+		mock.ExpectRollback()
+
+		outside, cleared, err := repo.UpdateEstate(context.Background(), estate, false)
+		assert.NoError(t, err)
+		assert.True(t, cleared)
+		assert.Empty(t, outside)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success test case: trees outside are archived", func(t *testing.T) {
+		estate := estate
+		estate.Width = 1
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectElevation).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"elevation"}).AddRow([]byte(`[[1,2,3,4,5],[6,7,8,9,10]]`)))
+		mock.ExpectQuery(update).
+			WithArgs(estateID, 5, 1, []byte(`[[1,2,3,4,5]]`), 2, 3, 4, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+		mock.ExpectQuery(archiveOutside).
+			WithArgs(estateID, 5, 1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow("tree-1", estateID, 6, 1, 10, createdAt, createdAt))
+		mock.ExpectCommit()
+
+		outside, cleared, err := repo.UpdateEstate(context.Background(), estate, true)
+		assert.NoError(t, err)
+		assert.False(t, cleared)
+		assert.Equal(t, []Tree{{ID: "tree-1", EstateID: estateID, X: 6, Y: 1, Height: 10, CreatedAt: createdAt, UpdatedAt: createdAt}}, outside)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success test case: elevation grid is cleared", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(selectElevation).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"elevation"}).AddRow([]byte(`[[1,2,3,4,5]]`)))
+		mock.ExpectQuery(update).
+			WithArgs(estateID, 5, 5, nil, 2, 3, 4, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+		mock.ExpectQuery(archiveOutside).
+			WithArgs(estateID, 5, 5).
+			WillReturnRows(sqlmock.NewRows(columns))
+		mock.ExpectCommit()
+
+		outside, cleared, err := repo.UpdateEstate(context.Background(), estate, true)
+		assert.NoError(t, err)
+		assert.True(t, cleared)
+		assert.Empty(t, outside)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func Test_DeleteEstate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "some-uuid"
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE estates SET deleted_at = now\(\), updated_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`).
+			WithArgs(estateID).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err := repo.DeleteEstate(context.Background(), estateID)
+		assert.Equal(t, sql.ErrNoRows, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE estates SET deleted_at = now\(\), updated_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+		mock.ExpectExec(`UPDATE trees SET deleted_at = now\(\), updated_at = now\(\) WHERE estate_id = \$1 AND deleted_at IS NULL`).
+			WithArgs(estateID).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectCommit()
+
+		err := repo.DeleteEstate(context.Background(), estateID)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func Test_RestoreEstate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "some-uuid"
+	restoreTrees := `UPDATE trees SET deleted_at = NULL, updated_at = now\(\) WHERE estate_id = \$1 AND deleted_at = \(SELECT deleted_at FROM estates WHERE id = \$1\)`
+	restoreEstate := `UPDATE estates SET deleted_at = NULL, updated_at = now\(\) WHERE id = \$1 AND deleted_at IS NOT NULL RETURNING id`
+
+	t.Run("failed test case: estate not deleted", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(restoreTrees).
+			WithArgs(estateID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(restoreEstate).
+			WithArgs(estateID).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err := repo.RestoreEstate(context.Background(), estateID)
+		assert.Equal(t, sql.ErrNoRows, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(restoreTrees).
+			WithArgs(estateID).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectQuery(restoreEstate).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+		mock.ExpectCommit()
+
+		err := repo.RestoreEstate(context.Background(), estateID)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func Test_UpdateEstateBoundary(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "some-uuid"
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE estates SET boundary = \$2, updated_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`).
+			WithArgs(estateID, nil).
+			WillReturnError(sql.ErrNoRows)
+
+		assert.Equal(t, sql.ErrNoRows, repo.UpdateEstateBoundary(context.Background(), estateID, nil))
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE estates SET boundary = \$2, updated_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`).
+			WithArgs(estateID, []byte(`[{"x":0,"y":0},{"x":10,"y":0},{"x":0,"y":20}]`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+
+		assert.NoError(t, repo.UpdateEstateBoundary(context.Background(), estateID, Boundary{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 20}}))
+	})
+}
+
+func Test_GetEstateElevation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "some-uuid"
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT elevation FROM estates WHERE id = \$1 AND deleted_at IS NULL`).
+			WithArgs(estateID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetEstateElevation(context.Background(), estateID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case: flat estate", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT elevation FROM estates WHERE id = \$1 AND deleted_at IS NULL`).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"elevation"}).AddRow(nil))
+
+		elevation, err := repo.GetEstateElevation(context.Background(), estateID)
+		assert.NoError(t, err)
+		assert.Nil(t, elevation)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT elevation FROM estates WHERE id = \$1 AND deleted_at IS NULL`).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"elevation"}).AddRow([]byte(`[[10,12],[11,15]]`)))
+
+		elevation, err := repo.GetEstateElevation(context.Background(), estateID)
+		assert.NoError(t, err)
+		assert.Equal(t, Elevation{{10, 12}, {11, 15}}, elevation)
+	})
+}
+
+func Test_UpdateEstateElevation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "some-uuid"
+	elevation := Elevation{{10, 12}, {11, 15}}
+
+	t.Run("failed test case: estate not found", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE estates SET elevation = \$2, updated_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`).
+			WithArgs(estateID, []byte(`[[10,12],[11,15]]`)).
+			WillReturnError(sql.ErrNoRows)
+
+		assert.Equal(t, sql.ErrNoRows, repo.UpdateEstateElevation(context.Background(), estateID, elevation))
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE estates SET elevation = \$2, updated_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`).
+			WithArgs(estateID, []byte(`[[10,12],[11,15]]`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(estateID))
+
+		assert.NoError(t, repo.UpdateEstateElevation(context.Background(), estateID, elevation))
+	})
+}
+
 func Test_CreateTree(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
@@ -58,7 +470,7 @@ func Test_CreateTree(t *testing.T) {
 			Height:   15,
 		}
 
-		mock.ExpectQuery(`INSERT INTO trees\(estate_id, x, y, height\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING id`).
+		mock.ExpectQuery(`WITH tree AS \( INSERT INTO trees\(estate_id, x, y, height\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING id, height, created_at \) INSERT INTO tree_heights\(tree_id, height, measured_at\) SELECT id, height, created_at FROM tree RETURNING tree_id`).
 			WithArgs(tree.EstateID, tree.X, tree.Y, tree.Height).
 			WillReturnError(assert.AnError)
 
@@ -76,9 +488,9 @@ func Test_CreateTree(t *testing.T) {
 		}
 		treeID := "some-tree-id"
 
-		mock.ExpectQuery(`INSERT INTO trees\(estate_id, x, y, height\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING id`).
+		mock.ExpectQuery(`WITH tree AS \( INSERT INTO trees\(estate_id, x, y, height\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING id, height, created_at \) INSERT INTO tree_heights\(tree_id, height, measured_at\) SELECT id, height, created_at FROM tree RETURNING tree_id`).
 			WithArgs(tree.EstateID, tree.X, tree.Y, tree.Height).
-			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(treeID))
+			WillReturnRows(sqlmock.NewRows([]string{"tree_id"}).AddRow(treeID))
 
 		id, err := repo.CreateTree(context.Background(), tree)
 		assert.NoError(t, err)
@@ -102,7 +514,9 @@ func Test_GetEstateStats(t *testing.T) {
 			Median:     10,
 		}
 
-		mock.ExpectQuery(`SELECT COUNT\(\*\) AS total_trees, COALESCE\(MAX\(height\), 0\) AS max_height, COALESCE\(MIN\(height\), 0\) AS min_height, COALESCE\(PERCENTILE_CONT\(0.5\) WITHIN GROUP \(ORDER BY height\), 0\) AS median_height FROM trees WHERE estate_id = \$1`).
+		mock.ExpectQuery(`SELECT COUNT\(\*\) AS total_trees, COALESCE\(MAX\(t.height\), 0\) AS max_height, COALESCE\(MIN\(t.height\), 0\) AS min_height, ` +
+			`COALESCE\(FLOOR\(PERCENTILE_CONT\(0.5\) WITHIN GROUP \(ORDER BY t.height\)\), 0\)::integer AS median_height ` +
+			`FROM trees t JOIN estates e ON e.id = t.estate_id WHERE t.estate_id = \$1 AND t.deleted_at IS NULL AND plot_inside\(e.boundary, t.x, t.y\)`).
 			WithArgs(estateID).
 			WillReturnRows(sqlmock.NewRows([]string{"total_trees", "max_height", "min_height", "median_height"}).
 				AddRow(expectedStats.TotalTrees, expectedStats.MaxHeight, expectedStats.MinHeight, expectedStats.Median))
@@ -113,7 +527,7 @@ func Test_GetEstateStats(t *testing.T) {
 	})
 
 	t.Run("failed test case: db error", func(t *testing.T) {
-		mock.ExpectQuery(`SELECT COUNT\(\*\).*WHERE estate_id = \$1`).
+		mock.ExpectQuery(`SELECT COUNT\(\*\).*WHERE t.estate_id = \$1`).
 			WithArgs(estateID).
 			WillReturnError(sql.ErrConnDone)
 
@@ -130,7 +544,9 @@ func Test_GetEstateStats(t *testing.T) {
 			Median:     10,
 		}
 
-		mock.ExpectQuery(`SELECT COUNT\(\*\) AS total_trees, COALESCE\(MAX\(height\), 0\) AS max_height, COALESCE\(MIN\(height\), 0\) AS min_height, COALESCE\(PERCENTILE_CONT\(0.5\) WITHIN GROUP \(ORDER BY height\), 0\) AS median_height FROM trees WHERE estate_id = \$1`).
+		mock.ExpectQuery(`SELECT COUNT\(\*\) AS total_trees, COALESCE\(MAX\(t.height\), 0\) AS max_height, COALESCE\(MIN\(t.height\), 0\) AS min_height, ` +
+			`COALESCE\(FLOOR\(PERCENTILE_CONT\(0.5\) WITHIN GROUP \(ORDER BY t.height\)\), 0\)::integer AS median_height ` +
+			`FROM trees t JOIN estates e ON e.id = t.estate_id WHERE t.estate_id = \$1 AND t.deleted_at IS NULL AND plot_inside\(e.boundary, t.x, t.y\)`).
 			WithArgs(estateID).
 			WillReturnRows(sqlmock.NewRows([]string{"total_trees", "max_height", "min_height", "median_height"}).
 				AddRow(expectedStats.TotalTrees, expectedStats.MaxHeight, expectedStats.MinHeight, expectedStats.Median))
@@ -150,7 +566,7 @@ func Test_GetEstateTrees(t *testing.T) {
 	estateID := "some-uuid"
 
 	t.Run("failed case: db error", func(t *testing.T) {
-		mock.ExpectQuery(`SELECT id, estate_id, x, y, height FROM trees WHERE estate_id = \$1 ORDER BY x, y`).
+		mock.ExpectQuery(`SELECT id, estate_id, x, y, height FROM trees WHERE estate_id = \$1 AND deleted_at IS NULL ORDER BY x, y`).
 			WithArgs(estateID).
 			WillReturnError(sql.ErrConnDone)
 
@@ -160,7 +576,7 @@ func Test_GetEstateTrees(t *testing.T) {
 	})
 
 	t.Run("failed case: row scan error", func(t *testing.T) {
-		mock.ExpectQuery(`SELECT id, estate_id, x, y, height FROM trees WHERE estate_id = \$1 ORDER BY x, y`).
+		mock.ExpectQuery(`SELECT id, estate_id, x, y, height FROM trees WHERE estate_id = \$1 AND deleted_at IS NULL ORDER BY x, y`).
 			WithArgs(estateID).
 			WillReturnRows(sqlmock.NewRows([]string{"id", "estate_id", "x", "y", "height"}).
 				AddRow(nil, nil, nil, nil, nil)) // Simulating a scan error
@@ -175,7 +591,7 @@ func Test_GetEstateTrees(t *testing.T) {
 			{ID: "tree-2", EstateID: estateID, X: 2, Y: 3, Height: 15},
 		}
 
-		mock.ExpectQuery(`SELECT id, estate_id, x, y, height FROM trees WHERE estate_id = \$1 ORDER BY x, y`).
+		mock.ExpectQuery(`SELECT id, estate_id, x, y, height FROM trees WHERE estate_id = \$1 AND deleted_at IS NULL ORDER BY x, y`).
 			WithArgs(estateID).
 			WillReturnRows(sqlmock.NewRows([]string{"id", "estate_id", "x", "y", "height"}).
 				AddRow(expectedTrees[0].ID, expectedTrees[0].EstateID, expectedTrees[0].X, expectedTrees[0].Y, expectedTrees[0].Height).
@@ -186,3 +602,667 @@ func Test_GetEstateTrees(t *testing.T) {
 		assert.Equal(t, expectedTrees, trees)
 	})
 }
+
+func Test_ListEstateTrees(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "estate-uuid"
+	columns := []string{"id", "estate_id", "x", "y", "height", "created_at", "updated_at"}
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("failed case: db error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id, estate_id, x, y, height, created_at, updated_at FROM trees WHERE estate_id = \$1 AND deleted_at IS NULL ORDER BY created_at ASC, id ASC LIMIT \$2`).
+			WithArgs(estateID, 10).
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.ListEstateTrees(context.Background(), estateID, TreeFilter{SortBy: SortByCreatedAt, Limit: 10})
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		minHeight, minX, maxX, maxY := 5, 2, 4, 3
+		filter := TreeFilter{
+			SortBy:       SortByPosition,
+			Descending:   true,
+			MinHeight:    &minHeight,
+			MinX:         &minX,
+			MaxX:         &maxX,
+			MaxY:         &maxY,
+			CreatedAfter: &createdAt,
+			After:        &TreeCursor{ID: "tree-0", X: 4, Y: 1},
+			Limit:        2,
+		}
+
+		mock.ExpectQuery(`SELECT id, estate_id, x, y, height, created_at, updated_at FROM trees `+
+			`WHERE estate_id = \$1 AND deleted_at IS NULL AND height >= \$2 AND x >= \$3 AND x <= \$4 AND y <= \$5 AND created_at > \$6 `+
+			`AND \(x, y, id\) < \(\$7, \$8, \$9\) ORDER BY x DESC, y DESC, id DESC LIMIT \$10`).
+			WithArgs(estateID, 5, 2, 4, 3, createdAt, 4, 1, "tree-0", 2).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("tree-1", estateID, 3, 3, 10, createdAt, createdAt).
+				AddRow("tree-2", estateID, 3, 1, 5, createdAt, createdAt))
+
+		trees, err := repo.ListEstateTrees(context.Background(), estateID, filter)
+		assert.NoError(t, err)
+		assert.Equal(t, []Tree{
+			{ID: "tree-1", EstateID: estateID, X: 3, Y: 3, Height: 10, CreatedAt: createdAt, UpdatedAt: createdAt},
+			{ID: "tree-2", EstateID: estateID, X: 3, Y: 1, Height: 5, CreatedAt: createdAt, UpdatedAt: createdAt},
+		}, trees)
+	})
+}
+
+func Test_GetEstateTree(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID, treeID := "estate-uuid", "tree-uuid"
+	query := `SELECT id, estate_id, x, y, height, created_at, updated_at, deleted_at FROM trees WHERE id = \$1 AND estate_id = \$2`
+
+	t.Run("failed test case: tree not found", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(treeID, estateID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetEstateTree(context.Background(), estateID, treeID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		deletedAt := createdAt.Add(time.Hour)
+		mock.ExpectQuery(query).
+			WithArgs(treeID, estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "estate_id", "x", "y", "height", "created_at", "updated_at", "deleted_at"}).
+				AddRow(treeID, estateID, 1, 2, 10, createdAt, createdAt, deletedAt))
+
+		tree, err := repo.GetEstateTree(context.Background(), estateID, treeID)
+		assert.NoError(t, err)
+		assert.Equal(t, Tree{ID: treeID, EstateID: estateID, X: 1, Y: 2, Height: 10, CreatedAt: createdAt, UpdatedAt: createdAt, DeletedAt: &deletedAt}, tree)
+	})
+}
+
+func Test_DeleteTree(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID, treeID := "estate-uuid", "tree-uuid"
+	query := `UPDATE trees SET deleted_at = now\(\), updated_at = now\(\) WHERE id = \$1 AND estate_id = \$2 AND deleted_at IS NULL RETURNING id`
+
+	t.Run("failed test case: tree not found", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(treeID, estateID).
+			WillReturnError(sql.ErrNoRows)
+
+		err := repo.DeleteTree(context.Background(), estateID, treeID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(treeID, estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(treeID))
+
+		err := repo.DeleteTree(context.Background(), estateID, treeID)
+		assert.NoError(t, err)
+	})
+}
+
+func Test_RestoreTree(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID, treeID := "estate-uuid", "tree-uuid"
+	query := `UPDATE trees SET deleted_at = NULL, updated_at = now\(\) WHERE id = \$1 AND estate_id = \$2 AND deleted_at IS NOT NULL RETURNING id`
+
+	t.Run("failed test case: tree not deleted", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(treeID, estateID).
+			WillReturnError(sql.ErrNoRows)
+
+		err := repo.RestoreTree(context.Background(), estateID, treeID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(treeID, estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(treeID))
+
+		err := repo.RestoreTree(context.Background(), estateID, treeID)
+		assert.NoError(t, err)
+	})
+}
+
+func Test_UpdateTreeHeight(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID, treeID := "estate-uuid", "tree-uuid"
+	query := `WITH tree AS \( UPDATE trees SET height = \$3, updated_at = now\(\) WHERE id = \$1 AND estate_id = \$2 AND deleted_at IS NULL RETURNING id, estate_id, x, y, height, created_at, updated_at \), measurement AS \( INSERT INTO tree_heights\(tree_id, height, measured_at\) SELECT id, height, updated_at FROM tree \) SELECT id, estate_id, x, y, height, created_at, updated_at FROM tree`
+
+	t.Run("failed test case: tree not found", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(treeID, estateID, 12).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.UpdateTreeHeight(context.Background(), estateID, treeID, 12)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		updatedAt := createdAt.Add(24 * time.Hour)
+		mock.ExpectQuery(query).
+			WithArgs(treeID, estateID, 12).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "estate_id", "x", "y", "height", "created_at", "updated_at"}).
+				AddRow(treeID, estateID, 1, 2, 12, createdAt, updatedAt))
+
+		tree, err := repo.UpdateTreeHeight(context.Background(), estateID, treeID, 12)
+		assert.NoError(t, err)
+		assert.Equal(t, Tree{ID: treeID, EstateID: estateID, X: 1, Y: 2, Height: 12, CreatedAt: createdAt, UpdatedAt: updatedAt}, tree)
+	})
+}
+
+func Test_GetTreeHeights(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	treeID := "tree-uuid"
+	query := `SELECT id, tree_id, height, measured_at FROM tree_heights WHERE tree_id = \$1 ORDER BY measured_at, id`
+
+	t.Run("failed case: db error", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(treeID).
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.GetTreeHeights(context.Background(), treeID)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		plantedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		expectedHeights := []TreeHeight{
+			{ID: "height-1", TreeID: treeID, Height: 5, MeasuredAt: plantedAt},
+			{ID: "height-2", TreeID: treeID, Height: 8, MeasuredAt: plantedAt.AddDate(0, 6, 0)},
+		}
+
+		mock.ExpectQuery(query).
+			WithArgs(treeID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "tree_id", "height", "measured_at"}).
+				AddRow(expectedHeights[0].ID, expectedHeights[0].TreeID, expectedHeights[0].Height, expectedHeights[0].MeasuredAt).
+				AddRow(expectedHeights[1].ID, expectedHeights[1].TreeID, expectedHeights[1].Height, expectedHeights[1].MeasuredAt))
+
+		heights, err := repo.GetTreeHeights(context.Background(), treeID)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedHeights, heights)
+	})
+}
+
+func Test_CreateLandingZone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	zone := LandingZone{EstateID: "some-estate-id", X: 5, Y: 10}
+
+	t.Run("failed test case: database error", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO landing_zones\(estate_id, x, y\) VALUES \(\$1, \$2, \$3\) RETURNING id`).
+			WithArgs(zone.EstateID, zone.X, zone.Y).
+			WillReturnError(assert.AnError)
+
+		id, err := repo.CreateLandingZone(context.Background(), zone)
+		assert.Error(t, err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO landing_zones\(estate_id, x, y\) VALUES \(\$1, \$2, \$3\) RETURNING id`).
+			WithArgs(zone.EstateID, zone.X, zone.Y).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("some-zone-id"))
+
+		id, err := repo.CreateLandingZone(context.Background(), zone)
+		assert.NoError(t, err)
+		assert.Equal(t, "some-zone-id", id)
+	})
+}
+
+func Test_GetEstateLandingZones(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "some-uuid"
+
+	t.Run("failed case: db error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id, estate_id, x, y FROM landing_zones WHERE estate_id = \$1 AND deleted_at IS NULL ORDER BY x, y`).
+			WithArgs(estateID).
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.GetEstateLandingZones(context.Background(), estateID)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		expectedZones := []LandingZone{
+			{ID: "zone-1", EstateID: estateID, X: 1, Y: 2},
+			{ID: "zone-2", EstateID: estateID, X: 4, Y: 3},
+		}
+
+		mock.ExpectQuery(`SELECT id, estate_id, x, y FROM landing_zones WHERE estate_id = \$1 AND deleted_at IS NULL ORDER BY x, y`).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "estate_id", "x", "y"}).
+				AddRow(expectedZones[0].ID, expectedZones[0].EstateID, expectedZones[0].X, expectedZones[0].Y).
+				AddRow(expectedZones[1].ID, expectedZones[1].EstateID, expectedZones[1].X, expectedZones[1].Y))
+
+		zones, err := repo.GetEstateLandingZones(context.Background(), estateID)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedZones, zones)
+	})
+}
+
+func Test_GetEstateLandingZone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID, zoneID := "estate-uuid", "zone-uuid"
+	query := `SELECT id, estate_id, x, y FROM landing_zones WHERE id = \$1 AND estate_id = \$2 AND deleted_at IS NULL`
+
+	t.Run("failed test case: landing zone not found", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(zoneID, estateID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetEstateLandingZone(context.Background(), estateID, zoneID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(zoneID, estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "estate_id", "x", "y"}).AddRow(zoneID, estateID, 2, 3))
+
+		zone, err := repo.GetEstateLandingZone(context.Background(), estateID, zoneID)
+		assert.NoError(t, err)
+		assert.Equal(t, LandingZone{ID: zoneID, EstateID: estateID, X: 2, Y: 3}, zone)
+	})
+}
+
+func Test_DeleteLandingZone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID, zoneID := "estate-uuid", "zone-uuid"
+	query := `UPDATE landing_zones SET deleted_at = now\(\) WHERE id = \$1 AND estate_id = \$2 AND deleted_at IS NULL RETURNING id`
+
+	t.Run("failed test case: landing zone not found", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(zoneID, estateID).
+			WillReturnError(sql.ErrNoRows)
+
+		err := repo.DeleteLandingZone(context.Background(), estateID, zoneID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(zoneID, estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(zoneID))
+
+		err := repo.DeleteLandingZone(context.Background(), estateID, zoneID)
+		assert.NoError(t, err)
+	})
+}
+
+func Test_CreateRestrictedArea(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	area := RestrictedArea{EstateID: "some-estate-id", Kind: ObstacleArea, X: 5, Y: 10, Length: 2, Width: 3, Height: 40}
+
+	t.Run("failed test case: database error", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO restricted_areas\(estate_id, kind, x, y, length, width, height\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING id`).
+			WithArgs(area.EstateID, area.Kind, area.X, area.Y, area.Length, area.Width, area.Height).
+			WillReturnError(assert.AnError)
+
+		id, err := repo.CreateRestrictedArea(context.Background(), area)
+		assert.Error(t, err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO restricted_areas\(estate_id, kind, x, y, length, width, height\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING id`).
+			WithArgs(area.EstateID, area.Kind, area.X, area.Y, area.Length, area.Width, area.Height).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("some-area-id"))
+
+		id, err := repo.CreateRestrictedArea(context.Background(), area)
+		assert.NoError(t, err)
+		assert.Equal(t, "some-area-id", id)
+	})
+}
+
+func Test_GetEstateRestrictedAreas(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID := "some-uuid"
+	columns := []string{"id", "estate_id", "kind", "x", "y", "length", "width", "height"}
+
+	t.Run("failed case: db error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id, estate_id, kind, x, y, length, width, height FROM restricted_areas WHERE estate_id = \$1 AND deleted_at IS NULL ORDER BY x, y`).
+			WithArgs(estateID).
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.GetEstateRestrictedAreas(context.Background(), estateID)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+
+	t.Run("failed case: row scan error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id, estate_id, kind, x, y, length, width, height FROM restricted_areas WHERE estate_id = \$1 AND deleted_at IS NULL ORDER BY x, y`).
+			WithArgs(estateID).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(nil, nil, nil, nil, nil, nil, nil, nil))
+
+		_, err := repo.GetEstateRestrictedAreas(context.Background(), estateID)
+		assert.Error(t, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		expectedAreas := []RestrictedArea{
+			{ID: "area-1", EstateID: estateID, Kind: NoFlyArea, X: 1, Y: 2, Length: 10, Width: 1},
+			{ID: "area-2", EstateID: estateID, Kind: ObstacleArea, X: 4, Y: 3, Length: 1, Width: 1, Height: 45},
+		}
+
+		rows := sqlmock.NewRows(columns)
+		for _, a := range expectedAreas {
+			rows.AddRow(a.ID, a.EstateID, a.Kind, a.X, a.Y, a.Length, a.Width, a.Height)
+		}
+		mock.ExpectQuery(`SELECT id, estate_id, kind, x, y, length, width, height FROM restricted_areas WHERE estate_id = \$1 AND deleted_at IS NULL ORDER BY x, y`).
+			WithArgs(estateID).
+			WillReturnRows(rows)
+
+		areas, err := repo.GetEstateRestrictedAreas(context.Background(), estateID)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedAreas, areas)
+	})
+}
+
+func Test_GetEstateRestrictedArea(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID, areaID := "estate-uuid", "area-uuid"
+	query := `SELECT id, estate_id, kind, x, y, length, width, height FROM restricted_areas WHERE id = \$1 AND estate_id = \$2 AND deleted_at IS NULL`
+
+	t.Run("failed test case: restricted area not found", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(areaID, estateID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetEstateRestrictedArea(context.Background(), estateID, areaID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(areaID, estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "estate_id", "kind", "x", "y", "length", "width", "height"}).
+				AddRow(areaID, estateID, ObstacleArea, 4, 3, 2, 1, 45))
+
+		area, err := repo.GetEstateRestrictedArea(context.Background(), estateID, areaID)
+		assert.NoError(t, err)
+		assert.Equal(t, RestrictedArea{ID: areaID, EstateID: estateID, Kind: ObstacleArea, X: 4, Y: 3, Length: 2, Width: 1, Height: 45}, area)
+	})
+}
+
+func Test_DeleteRestrictedArea(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	estateID, areaID := "estate-uuid", "area-uuid"
+	query := `UPDATE restricted_areas SET deleted_at = now\(\) WHERE id = \$1 AND estate_id = \$2 AND deleted_at IS NULL RETURNING id`
+
+	t.Run("failed test case: restricted area not found", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(areaID, estateID).
+			WillReturnError(sql.ErrNoRows)
+
+		err := repo.DeleteRestrictedArea(context.Background(), estateID, areaID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(areaID, estateID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(areaID))
+
+		err := repo.DeleteRestrictedArea(context.Background(), estateID, areaID)
+		assert.NoError(t, err)
+	})
+}
+
+func Test_CreateDrone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	drone := Drone{Model: "T40", Range: 4000, Speed: 10, ClimbRate: 4, DescendRate: 3,
+		HorizontalEnergy: 0.1, ClimbEnergy: 0.4, DescendEnergy: 0.05, Clearance: 2}
+
+	t.Run("failed test case: database error", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO drones\(model, range, speed, climb_rate, descend_rate, horizontal_energy, climb_energy, descend_energy, clearance\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9\) RETURNING id`).
+			WithArgs(drone.Model, drone.Range, drone.Speed, drone.ClimbRate, drone.DescendRate,
+				drone.HorizontalEnergy, drone.ClimbEnergy, drone.DescendEnergy, drone.Clearance).
+			WillReturnError(sql.ErrConnDone)
+
+		id, err := repo.CreateDrone(context.Background(), drone)
+		assert.Error(t, err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO drones\(model, range, speed, climb_rate, descend_rate, horizontal_energy, climb_energy, descend_energy, clearance\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9\) RETURNING id`).
+			WithArgs(drone.Model, drone.Range, drone.Speed, drone.ClimbRate, drone.DescendRate,
+				drone.HorizontalEnergy, drone.ClimbEnergy, drone.DescendEnergy, drone.Clearance).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("drone-1"))
+
+		id, err := repo.CreateDrone(context.Background(), drone)
+		assert.NoError(t, err)
+		assert.Equal(t, "drone-1", id)
+	})
+}
+
+func Test_GetDrones(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	columns := []string{"id", "model", "range", "speed", "climb_rate", "descend_rate", "horizontal_energy", "climb_energy", "descend_energy", "clearance"}
+
+	t.Run("failed case: db error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id, model, range, speed, climb_rate, descend_rate, horizontal_energy, climb_energy, descend_energy, clearance FROM drones WHERE deleted_at IS NULL ORDER BY created_at, id`).
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.GetDrones(context.Background())
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		expectedDrones := []Drone{
+			{ID: "drone-1", Model: "T40", Range: 4000, Speed: 10, ClimbRate: 4, DescendRate: 3,
+				HorizontalEnergy: 0.1, ClimbEnergy: 0.4, DescendEnergy: 0.05, Clearance: 2},
+			{ID: "drone-2", Model: "T20", Range: 2500, Speed: 7, ClimbRate: 3, DescendRate: 3,
+				HorizontalEnergy: 0.08, ClimbEnergy: 0.3, DescendEnergy: 0.04, Clearance: 1},
+		}
+
+		rows := sqlmock.NewRows(columns)
+		for _, drone := range expectedDrones {
+			rows.AddRow(drone.ID, drone.Model, drone.Range, drone.Speed, drone.ClimbRate, drone.DescendRate,
+				drone.HorizontalEnergy, drone.ClimbEnergy, drone.DescendEnergy, drone.Clearance)
+		}
+		mock.ExpectQuery(`SELECT id, model, range, speed, climb_rate, descend_rate, horizontal_energy, climb_energy, descend_energy, clearance FROM drones WHERE deleted_at IS NULL ORDER BY created_at, id`).
+			WillReturnRows(rows)
+
+		drones, err := repo.GetDrones(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, expectedDrones, drones)
+	})
+}
+
+func Test_GetDroneByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	droneID := "drone-1"
+
+	t.Run("failed test case: drone not found", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id, model, range, speed, climb_rate, descend_rate, horizontal_energy, climb_energy, descend_energy, clearance FROM drones WHERE id = \$1 AND deleted_at IS NULL`).
+			WithArgs(droneID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetDroneByID(context.Background(), droneID)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT id, model, range, speed, climb_rate, descend_rate, horizontal_energy, climb_energy, descend_energy, clearance FROM drones WHERE id = \$1 AND deleted_at IS NULL`).
+			WithArgs(droneID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "model", "range", "speed", "climb_rate", "descend_rate", "horizontal_energy", "climb_energy", "descend_energy", "clearance"}).
+				AddRow(droneID, "T40", 4000, 10.0, 4.0, 3.0, 0.1, 0.4, 0.05, 2))
+
+		drone, err := repo.GetDroneByID(context.Background(), droneID)
+		assert.NoError(t, err)
+		assert.Equal(t, Drone{ID: droneID, Model: "T40", Range: 4000, Speed: 10, ClimbRate: 4, DescendRate: 3,
+			HorizontalEnergy: 0.1, ClimbEnergy: 0.4, DescendEnergy: 0.05, Clearance: 2}, drone)
+	})
+}
+
+func Test_UpdateDrone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	drone := Drone{ID: "drone-1", Model: "T40", Range: 4000, Speed: 10, ClimbRate: 4, DescendRate: 3,
+		HorizontalEnergy: 0.1, ClimbEnergy: 0.4, DescendEnergy: 0.05, Clearance: 2}
+
+	t.Run("failed test case: drone not found", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE drones SET model = \$2, range = \$3, speed = \$4, climb_rate = \$5, descend_rate = \$6, horizontal_energy = \$7, climb_energy = \$8, descend_energy = \$9, clearance = \$10, updated_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`).
+			WithArgs(drone.ID, drone.Model, drone.Range, drone.Speed, drone.ClimbRate, drone.DescendRate,
+				drone.HorizontalEnergy, drone.ClimbEnergy, drone.DescendEnergy, drone.Clearance).
+			WillReturnError(sql.ErrNoRows)
+
+		assert.Equal(t, sql.ErrNoRows, repo.UpdateDrone(context.Background(), drone))
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE drones SET model = \$2, range = \$3, speed = \$4, climb_rate = \$5, descend_rate = \$6, horizontal_energy = \$7, climb_energy = \$8, descend_energy = \$9, clearance = \$10, updated_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`).
+			WithArgs(drone.ID, drone.Model, drone.Range, drone.Speed, drone.ClimbRate, drone.DescendRate,
+				drone.HorizontalEnergy, drone.ClimbEnergy, drone.DescendEnergy, drone.Clearance).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(drone.ID))
+
+		assert.NoError(t, repo.UpdateDrone(context.Background(), drone))
+	})
+}
+
+func Test_DeleteDrone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	droneID := "drone-1"
+
+	t.Run("failed test case: drone not found", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE drones SET deleted_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`).
+			WithArgs(droneID).
+			WillReturnError(sql.ErrNoRows)
+
+		assert.Equal(t, sql.ErrNoRows, repo.DeleteDrone(context.Background(), droneID))
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE drones SET deleted_at = now\(\) WHERE id = \$1 AND deleted_at IS NULL RETURNING id`).
+			WithArgs(droneID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(droneID))
+
+		assert.NoError(t, repo.DeleteDrone(context.Background(), droneID))
+	})
+}
+
+func Test_PurgeDeleted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := Repository{Db: db}
+	before := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("failed case: db error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM tree_heights WHERE tree_id IN \(SELECT id FROM trees WHERE deleted_at < \$1 OR estate_id IN \(SELECT id FROM estates WHERE deleted_at < \$1\)\)`).
+			WithArgs(before).
+			WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		_, err := repo.PurgeDeleted(context.Background(), before)
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success test case", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM tree_heights WHERE tree_id IN \(SELECT id FROM trees WHERE deleted_at < \$1 OR estate_id IN \(SELECT id FROM estates WHERE deleted_at < \$1\)\)`).
+			WithArgs(before).
+			WillReturnResult(sqlmock.NewResult(0, 6))
+		mock.ExpectExec(`DELETE FROM trees WHERE deleted_at < \$1 OR estate_id IN \(SELECT id FROM estates WHERE deleted_at < \$1\)`).
+			WithArgs(before).
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec(`DELETE FROM landing_zones WHERE deleted_at < \$1 OR estate_id IN \(SELECT id FROM estates WHERE deleted_at < \$1\)`).
+			WithArgs(before).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM restricted_areas WHERE deleted_at < \$1 OR estate_id IN \(SELECT id FROM estates WHERE deleted_at < \$1\)`).
+			WithArgs(before).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DELETE FROM estates WHERE deleted_at < \$1`).
+			WithArgs(before).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM drones WHERE deleted_at < \$1`).
+			WithArgs(before).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		purged, err := repo.PurgeDeleted(context.Background(), before)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(14), purged)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -4,12 +4,43 @@
 // interfaces using mockgen. See the Makefile for more information.
 package repository
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type RepositoryInterface interface {
 	CreateEstate(ctx context.Context, estate Estate) (id string, err error)
 	GetEstateByID(ctx context.Context, ID string) (estate Estate, err error)
+	ListEstates(ctx context.Context, filter EstateFilter) (estates []EstateSummary, err error)
+	GetEstateSummary(ctx context.Context, ID string) (estate EstateSummary, err error)
+	UpdateEstate(ctx context.Context, estate Estate, archive bool) (outside []Tree, cleared bool, err error)
+	DeleteEstate(ctx context.Context, ID string) (err error)
+	RestoreEstate(ctx context.Context, ID string) (err error)
+	UpdateEstateBoundary(ctx context.Context, ID string, boundary Boundary) (err error)
+	GetEstateElevation(ctx context.Context, ID string) (elevation Elevation, err error)
+	UpdateEstateElevation(ctx context.Context, ID string, elevation Elevation) (err error)
 	CreateTree(ctx context.Context, tree Tree) (id string, err error)
 	GetEstateStats(ctx context.Context, ID string) (stats Stats, err error)
 	GetEstateTrees(ctx context.Context, ID string) (trees []Tree, err error)
+	ListEstateTrees(ctx context.Context, estateID string, filter TreeFilter) (trees []Tree, err error)
+	GetEstateTree(ctx context.Context, estateID, ID string) (tree Tree, err error)
+	DeleteTree(ctx context.Context, estateID, ID string) (err error)
+	RestoreTree(ctx context.Context, estateID, ID string) (err error)
+	UpdateTreeHeight(ctx context.Context, estateID, ID string, height int) (tree Tree, err error)
+	GetTreeHeights(ctx context.Context, ID string) (heights []TreeHeight, err error)
+	CreateLandingZone(ctx context.Context, zone LandingZone) (id string, err error)
+	GetEstateLandingZones(ctx context.Context, ID string) (zones []LandingZone, err error)
+	GetEstateLandingZone(ctx context.Context, estateID, ID string) (zone LandingZone, err error)
+	DeleteLandingZone(ctx context.Context, estateID, ID string) (err error)
+	CreateRestrictedArea(ctx context.Context, area RestrictedArea) (id string, err error)
+	GetEstateRestrictedAreas(ctx context.Context, ID string) (areas []RestrictedArea, err error)
+	GetEstateRestrictedArea(ctx context.Context, estateID, ID string) (area RestrictedArea, err error)
+	DeleteRestrictedArea(ctx context.Context, estateID, ID string) (err error)
+	CreateDrone(ctx context.Context, drone Drone) (id string, err error)
+	GetDrones(ctx context.Context) (drones []Drone, err error)
+	GetDroneByID(ctx context.Context, ID string) (drone Drone, err error)
+	UpdateDrone(ctx context.Context, drone Drone) (err error)
+	DeleteDrone(ctx context.Context, ID string) (err error)
+	PurgeDeleted(ctx context.Context, before time.Time) (purged int64, err error)
 }
@@ -7,6 +7,7 @@ package repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -34,6 +35,21 @@ func (m *MockRepositoryInterface) EXPECT() *MockRepositoryInterfaceMockRecorder
 	return m.recorder
 }
 
+// CreateDrone mocks base method.
+func (m *MockRepositoryInterface) CreateDrone(ctx context.Context, drone Drone) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDrone", ctx, drone)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDrone indicates an expected call of CreateDrone.
+func (mr *MockRepositoryInterfaceMockRecorder) CreateDrone(ctx, drone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDrone", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateDrone), ctx, drone)
+}
+
 // CreateEstate mocks base method.
 func (m *MockRepositoryInterface) CreateEstate(ctx context.Context, estate Estate) (string, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +65,36 @@ func (mr *MockRepositoryInterfaceMockRecorder) CreateEstate(ctx, estate interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEstate", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateEstate), ctx, estate)
 }
 
+// CreateLandingZone mocks base method.
+func (m *MockRepositoryInterface) CreateLandingZone(ctx context.Context, zone LandingZone) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLandingZone", ctx, zone)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLandingZone indicates an expected call of CreateLandingZone.
+func (mr *MockRepositoryInterfaceMockRecorder) CreateLandingZone(ctx, zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLandingZone", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateLandingZone), ctx, zone)
+}
+
+// CreateRestrictedArea mocks base method.
+func (m *MockRepositoryInterface) CreateRestrictedArea(ctx context.Context, area RestrictedArea) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRestrictedArea", ctx, area)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRestrictedArea indicates an expected call of CreateRestrictedArea.
+func (mr *MockRepositoryInterfaceMockRecorder) CreateRestrictedArea(ctx, area interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestrictedArea", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateRestrictedArea), ctx, area)
+}
+
 // CreateTree mocks base method.
 func (m *MockRepositoryInterface) CreateTree(ctx context.Context, tree Tree) (string, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +110,106 @@ func (mr *MockRepositoryInterfaceMockRecorder) CreateTree(ctx, tree interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTree", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateTree), ctx, tree)
 }
 
+// DeleteDrone mocks base method.
+func (m *MockRepositoryInterface) DeleteDrone(ctx context.Context, ID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDrone", ctx, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDrone indicates an expected call of DeleteDrone.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteDrone(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDrone", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteDrone), ctx, ID)
+}
+
+// DeleteEstate mocks base method.
+func (m *MockRepositoryInterface) DeleteEstate(ctx context.Context, ID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEstate", ctx, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEstate indicates an expected call of DeleteEstate.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteEstate(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEstate", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteEstate), ctx, ID)
+}
+
+// DeleteLandingZone mocks base method.
+func (m *MockRepositoryInterface) DeleteLandingZone(ctx context.Context, estateID, ID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLandingZone", ctx, estateID, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLandingZone indicates an expected call of DeleteLandingZone.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteLandingZone(ctx, estateID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLandingZone", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteLandingZone), ctx, estateID, ID)
+}
+
+// DeleteRestrictedArea mocks base method.
+func (m *MockRepositoryInterface) DeleteRestrictedArea(ctx context.Context, estateID, ID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRestrictedArea", ctx, estateID, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRestrictedArea indicates an expected call of DeleteRestrictedArea.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteRestrictedArea(ctx, estateID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRestrictedArea", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteRestrictedArea), ctx, estateID, ID)
+}
+
+// DeleteTree mocks base method.
+func (m *MockRepositoryInterface) DeleteTree(ctx context.Context, estateID, ID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTree", ctx, estateID, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTree indicates an expected call of DeleteTree.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteTree(ctx, estateID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTree", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteTree), ctx, estateID, ID)
+}
+
+// GetDroneByID mocks base method.
+func (m *MockRepositoryInterface) GetDroneByID(ctx context.Context, ID string) (Drone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDroneByID", ctx, ID)
+	ret0, _ := ret[0].(Drone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDroneByID indicates an expected call of GetDroneByID.
+func (mr *MockRepositoryInterfaceMockRecorder) GetDroneByID(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDroneByID", reflect.TypeOf((*MockRepositoryInterface)(nil).GetDroneByID), ctx, ID)
+}
+
+// GetDrones mocks base method.
+func (m *MockRepositoryInterface) GetDrones(ctx context.Context) ([]Drone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDrones", ctx)
+	ret0, _ := ret[0].([]Drone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDrones indicates an expected call of GetDrones.
+func (mr *MockRepositoryInterfaceMockRecorder) GetDrones(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDrones", reflect.TypeOf((*MockRepositoryInterface)(nil).GetDrones), ctx)
+}
+
 // GetEstateByID mocks base method.
 func (m *MockRepositoryInterface) GetEstateByID(ctx context.Context, ID string) (Estate, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +225,81 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetEstateByID(ctx, ID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateByID", reflect.TypeOf((*MockRepositoryInterface)(nil).GetEstateByID), ctx, ID)
 }
 
+// GetEstateElevation mocks base method.
+func (m *MockRepositoryInterface) GetEstateElevation(ctx context.Context, ID string) (Elevation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstateElevation", ctx, ID)
+	ret0, _ := ret[0].(Elevation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstateElevation indicates an expected call of GetEstateElevation.
+func (mr *MockRepositoryInterfaceMockRecorder) GetEstateElevation(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateElevation", reflect.TypeOf((*MockRepositoryInterface)(nil).GetEstateElevation), ctx, ID)
+}
+
+// GetEstateLandingZone mocks base method.
+func (m *MockRepositoryInterface) GetEstateLandingZone(ctx context.Context, estateID, ID string) (LandingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstateLandingZone", ctx, estateID, ID)
+	ret0, _ := ret[0].(LandingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstateLandingZone indicates an expected call of GetEstateLandingZone.
+func (mr *MockRepositoryInterfaceMockRecorder) GetEstateLandingZone(ctx, estateID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateLandingZone", reflect.TypeOf((*MockRepositoryInterface)(nil).GetEstateLandingZone), ctx, estateID, ID)
+}
+
+// GetEstateLandingZones mocks base method.
+func (m *MockRepositoryInterface) GetEstateLandingZones(ctx context.Context, ID string) ([]LandingZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstateLandingZones", ctx, ID)
+	ret0, _ := ret[0].([]LandingZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstateLandingZones indicates an expected call of GetEstateLandingZones.
+func (mr *MockRepositoryInterfaceMockRecorder) GetEstateLandingZones(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateLandingZones", reflect.TypeOf((*MockRepositoryInterface)(nil).GetEstateLandingZones), ctx, ID)
+}
+
+// GetEstateRestrictedArea mocks base method.
+func (m *MockRepositoryInterface) GetEstateRestrictedArea(ctx context.Context, estateID, ID string) (RestrictedArea, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstateRestrictedArea", ctx, estateID, ID)
+	ret0, _ := ret[0].(RestrictedArea)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstateRestrictedArea indicates an expected call of GetEstateRestrictedArea.
+func (mr *MockRepositoryInterfaceMockRecorder) GetEstateRestrictedArea(ctx, estateID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateRestrictedArea", reflect.TypeOf((*MockRepositoryInterface)(nil).GetEstateRestrictedArea), ctx, estateID, ID)
+}
+
+// GetEstateRestrictedAreas mocks base method.
+func (m *MockRepositoryInterface) GetEstateRestrictedAreas(ctx context.Context, ID string) ([]RestrictedArea, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstateRestrictedAreas", ctx, ID)
+	ret0, _ := ret[0].([]RestrictedArea)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstateRestrictedAreas indicates an expected call of GetEstateRestrictedAreas.
+func (mr *MockRepositoryInterfaceMockRecorder) GetEstateRestrictedAreas(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateRestrictedAreas", reflect.TypeOf((*MockRepositoryInterface)(nil).GetEstateRestrictedAreas), ctx, ID)
+}
+
 // GetEstateStats mocks base method.
 func (m *MockRepositoryInterface) GetEstateStats(ctx context.Context, ID string) (Stats, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +315,36 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetEstateStats(ctx, ID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateStats", reflect.TypeOf((*MockRepositoryInterface)(nil).GetEstateStats), ctx, ID)
 }
 
+// GetEstateSummary mocks base method.
+func (m *MockRepositoryInterface) GetEstateSummary(ctx context.Context, ID string) (EstateSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstateSummary", ctx, ID)
+	ret0, _ := ret[0].(EstateSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstateSummary indicates an expected call of GetEstateSummary.
+func (mr *MockRepositoryInterfaceMockRecorder) GetEstateSummary(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateSummary", reflect.TypeOf((*MockRepositoryInterface)(nil).GetEstateSummary), ctx, ID)
+}
+
+// GetEstateTree mocks base method.
+func (m *MockRepositoryInterface) GetEstateTree(ctx context.Context, estateID, ID string) (Tree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEstateTree", ctx, estateID, ID)
+	ret0, _ := ret[0].(Tree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEstateTree indicates an expected call of GetEstateTree.
+func (mr *MockRepositoryInterfaceMockRecorder) GetEstateTree(ctx, estateID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateTree", reflect.TypeOf((*MockRepositoryInterface)(nil).GetEstateTree), ctx, estateID, ID)
+}
+
 // GetEstateTrees mocks base method.
 func (m *MockRepositoryInterface) GetEstateTrees(ctx context.Context, ID string) ([]Tree, error) {
 	m.ctrl.T.Helper()
@@ -108,3 +359,164 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetEstateTrees(ctx, ID interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEstateTrees", reflect.TypeOf((*MockRepositoryInterface)(nil).GetEstateTrees), ctx, ID)
 }
+
+// GetTreeHeights mocks base method.
+func (m *MockRepositoryInterface) GetTreeHeights(ctx context.Context, ID string) ([]TreeHeight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreeHeights", ctx, ID)
+	ret0, _ := ret[0].([]TreeHeight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreeHeights indicates an expected call of GetTreeHeights.
+func (mr *MockRepositoryInterfaceMockRecorder) GetTreeHeights(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreeHeights", reflect.TypeOf((*MockRepositoryInterface)(nil).GetTreeHeights), ctx, ID)
+}
+
+// ListEstateTrees mocks base method.
+func (m *MockRepositoryInterface) ListEstateTrees(ctx context.Context, estateID string, filter TreeFilter) ([]Tree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEstateTrees", ctx, estateID, filter)
+	ret0, _ := ret[0].([]Tree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEstateTrees indicates an expected call of ListEstateTrees.
+func (mr *MockRepositoryInterfaceMockRecorder) ListEstateTrees(ctx, estateID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEstateTrees", reflect.TypeOf((*MockRepositoryInterface)(nil).ListEstateTrees), ctx, estateID, filter)
+}
+
+// ListEstates mocks base method.
+func (m *MockRepositoryInterface) ListEstates(ctx context.Context, filter EstateFilter) ([]EstateSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEstates", ctx, filter)
+	ret0, _ := ret[0].([]EstateSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEstates indicates an expected call of ListEstates.
+func (mr *MockRepositoryInterfaceMockRecorder) ListEstates(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEstates", reflect.TypeOf((*MockRepositoryInterface)(nil).ListEstates), ctx, filter)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockRepositoryInterface) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockRepositoryInterfaceMockRecorder) PurgeDeleted(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockRepositoryInterface)(nil).PurgeDeleted), ctx, before)
+}
+
+// RestoreEstate mocks base method.
+func (m *MockRepositoryInterface) RestoreEstate(ctx context.Context, ID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEstate", ctx, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreEstate indicates an expected call of RestoreEstate.
+func (mr *MockRepositoryInterfaceMockRecorder) RestoreEstate(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEstate", reflect.TypeOf((*MockRepositoryInterface)(nil).RestoreEstate), ctx, ID)
+}
+
+// RestoreTree mocks base method.
+func (m *MockRepositoryInterface) RestoreTree(ctx context.Context, estateID, ID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTree", ctx, estateID, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreTree indicates an expected call of RestoreTree.
+func (mr *MockRepositoryInterfaceMockRecorder) RestoreTree(ctx, estateID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTree", reflect.TypeOf((*MockRepositoryInterface)(nil).RestoreTree), ctx, estateID, ID)
+}
+
+// UpdateDrone mocks base method.
+func (m *MockRepositoryInterface) UpdateDrone(ctx context.Context, drone Drone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDrone", ctx, drone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDrone indicates an expected call of UpdateDrone.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateDrone(ctx, drone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDrone", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateDrone), ctx, drone)
+}
+
+// UpdateEstate mocks base method.
+func (m *MockRepositoryInterface) UpdateEstate(ctx context.Context, estate Estate, archive bool) ([]Tree, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEstate", ctx, estate, archive)
+	ret0, _ := ret[0].([]Tree)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateEstate indicates an expected call of UpdateEstate.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateEstate(ctx, estate, archive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEstate", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateEstate), ctx, estate, archive)
+}
+
+// UpdateEstateBoundary mocks base method.
+func (m *MockRepositoryInterface) UpdateEstateBoundary(ctx context.Context, ID string, boundary Boundary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEstateBoundary", ctx, ID, boundary)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEstateBoundary indicates an expected call of UpdateEstateBoundary.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateEstateBoundary(ctx, ID, boundary interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEstateBoundary", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateEstateBoundary), ctx, ID, boundary)
+}
+
+// UpdateEstateElevation mocks base method.
+func (m *MockRepositoryInterface) UpdateEstateElevation(ctx context.Context, ID string, elevation Elevation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEstateElevation", ctx, ID, elevation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEstateElevation indicates an expected call of UpdateEstateElevation.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateEstateElevation(ctx, ID, elevation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEstateElevation", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateEstateElevation), ctx, ID, elevation)
+}
+
+// UpdateTreeHeight mocks base method.
+func (m *MockRepositoryInterface) UpdateTreeHeight(ctx context.Context, estateID, ID string, height int) (Tree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTreeHeight", ctx, estateID, ID, height)
+	ret0, _ := ret[0].(Tree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTreeHeight indicates an expected call of UpdateTreeHeight.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateTreeHeight(ctx, estateID, ID, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTreeHeight", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateTreeHeight), ctx, estateID, ID, height)
+}
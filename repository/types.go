@@ -1,7 +1,12 @@
 // This file contains types that are used in the repository layer.
 package repository
 
-import "time"
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
 type GetTestByIdInput struct {
 	Id string
@@ -12,12 +17,179 @@ type GetTestByIdOutput struct {
 }
 
 type Estate struct {
+	ID              string
+	Length          int
+	Width           int
+	PlotSize        int
+	OriginLatitude  *float64
+	OriginLongitude *float64
+	Rotation        float64
+	DroneProfile    DroneProfile
+	HomeX           *int
+	HomeY           *int
+	Boundary        Boundary
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       *time.Time
+}
+
+// Sort keys of the estate list.
+const (
+	SortByCreatedAt = "created_at"
+	SortByArea      = "area"
+)
+
+// EstateFilter selects a page of the estates that are not deleted. Area is
+// the number of plots and the trees are counted without the deleted ones,
+// a nil bound is not checked. The page starts right after the After cursor
+// in the sort order, from the first estate without it.
+type EstateFilter struct {
+	SortBy     string
+	Descending bool
+	MinArea    *int
+	MaxArea    *int
+	MinTrees   *int
+	MaxTrees   *int
+	After      *EstateCursor
+	Limit      int
+}
+
+// EstateCursor is the position of an estate in the estate list, only the
+// sort key of the list and the ID are used.
+type EstateCursor struct {
+	ID        string
+	CreatedAt time.Time
+	Area      int64
+}
+
+// Sort keys of the tree list besides SortByCreatedAt, position sorting by x
+// then y.
+const (
+	SortByPosition = "position"
+	SortByHeight   = "height"
+)
+
+// TreeFilter selects a page of the trees of an estate that are not deleted,
+// within the bounding box of plots from (MinX, MinY) to (MaxX, MaxY). A nil
+// bound is not checked. The page starts right after the After cursor in the
+// sort order, from the first tree without it.
+type TreeFilter struct {
+	SortBy       string
+	Descending   bool
+	MinHeight    *int
+	MaxHeight    *int
+	MinX         *int
+	MaxX         *int
+	MinY         *int
+	MaxY         *int
+	CreatedAfter *time.Time
+	After        *TreeCursor
+	Limit        int
+}
+
+// TreeCursor is the position of a tree in the tree list, only the sort key
+// of the list and the ID are used.
+type TreeCursor struct {
+	ID        string
+	X         int
+	Y         int
+	Height    int
+	CreatedAt time.Time
+}
+
+// EstateSummary is an estate as listed, with the number of its trees.
+type EstateSummary struct {
 	ID        string
 	Length    int
 	Width     int
+	PlotSize  int
+	TreeCount int
 	CreatedAt time.Time
 	UpdatedAt time.Time
-	DeletedAt *time.Time
+}
+
+// DroneProfile holds the flight parameters of the drone in meters.
+type DroneProfile struct {
+	Clearance       int
+	TakeoffAltitude int
+	LandingAltitude int
+	CruiseFloor     int
+}
+
+// Vertex is a corner of the boundary of an estate, counted in plots from
+// the outer corner of plot (1, 1).
+type Vertex struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Boundary is the polygon enclosing the plots of an estate that is not a
+// full rectangle, nil otherwise. It is stored as JSON.
+type Boundary []Vertex
+
+func (b Boundary) Value() (driver.Value, error) {
+	if b == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(b)
+}
+
+func (b *Boundary) Scan(src any) error {
+	if src == nil {
+		*b = nil
+		return nil
+	}
+
+	return scanJSON(src, b)
+}
+
+// Elevation is the ground elevation in meters of every plot of an estate,
+// row y - 1 holding the plots (1, y) to (length, y), nil for flat ground.
+// It is stored as JSON.
+type Elevation [][]int
+
+func (e Elevation) Value() (driver.Value, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(e)
+}
+
+func (e *Elevation) Scan(src any) error {
+	if src == nil {
+		*e = nil
+		return nil
+	}
+
+	return scanJSON(src, e)
+}
+
+// Crop returns the elevation grid resized to length by width plots, nil
+// when the estate grows since the new plots have no elevation.
+func (e Elevation) Crop(length, width int) Elevation {
+	if width > len(e) || len(e) > 0 && length > len(e[0]) {
+		return nil
+	}
+
+	cropped := make(Elevation, 0, width)
+	for _, row := range e[:width] {
+		cropped = append(cropped, append([]int(nil), row[:length]...))
+	}
+
+	return cropped
+}
+
+func scanJSON(src any, dest any) error {
+	switch src := src.(type) {
+	case []byte:
+		return json.Unmarshal(src, dest)
+	case string:
+		return json.Unmarshal([]byte(src), dest)
+	default:
+		return fmt.Errorf("cannot scan %T into %T", src, dest)
+	}
 }
 
 type Tree struct {
@@ -31,6 +203,65 @@ type Tree struct {
 	DeletedAt *time.Time
 }
 
+// TreeHeight is a measurement of the height of a tree, the height of the tree
+// is the latest one.
+type TreeHeight struct {
+	ID         string
+	TreeID     string
+	Height     int
+	MeasuredAt time.Time
+}
+
+// LandingZone is a plot of an estate where the drone may land to rest.
+type LandingZone struct {
+	ID        string
+	EstateID  string
+	X         int
+	Y         int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// Kinds of restricted areas.
+const (
+	NoFlyArea    = "no_fly"
+	ObstacleArea = "obstacle"
+)
+
+// RestrictedArea is a rectangle of plots of an estate, starting at plot
+// (X, Y), that the drone must not fly over, or must climb over when it is
+// an obstacle of the given height.
+type RestrictedArea struct {
+	ID        string
+	EstateID  string
+	Kind      string
+	X         int
+	Y         int
+	Length    int
+	Width     int
+	Height    int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+type Drone struct {
+	ID               string
+	Model            string
+	Range            int
+	Speed            float64
+	ClimbRate        float64
+	DescendRate      float64
+	HorizontalEnergy float64
+	ClimbEnergy      float64
+	DescendEnergy    float64
+	Clearance        int
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        *time.Time
+}
+
 type Stats struct {
 	TotalTrees int
 	MaxHeight  int
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ElevationCrop(t *testing.T) {
+	elevation := Elevation{{1, 2, 3}, {4, 5, 6}}
+
+	assert.Equal(t, Elevation{{1, 2}}, elevation.Crop(2, 1))
+	assert.Equal(t, elevation, elevation.Crop(3, 2))
+	assert.Nil(t, elevation.Crop(4, 2))
+	assert.Nil(t, elevation.Crop(3, 3))
+	assert.Nil(t, Elevation(nil).Crop(3, 2))
+}